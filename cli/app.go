@@ -231,8 +231,12 @@ func runAction(cliCtx *cli.Context, opts *options.TerragruntOptions, action cli.
 
 // mostly preparing terragrunt options
 func initialSetup(cliCtx *cli.Context, opts *options.TerragruntOptions) error {
-	// convert the rest flags (intended for terraform) to one dash, e.g. `--input=true` to `-input=true`
-	args := cliCtx.Args().WithoutBuiltinCmdSep().Normalize(cli.SingleDashFlag)
+	args := cliCtx.Args().WithoutBuiltinCmdSep()
+
+	// convert the rest flags (intended for terraform) to one dash, e.g. `--input=true` to `-input=true`, unless they are passed verbatim
+	if !opts.PassthroughAll {
+		args = args.Normalize(cli.SingleDashFlag)
+	}
 	cmdName := cliCtx.Command.Name
 
 	switch {
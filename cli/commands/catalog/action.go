@@ -11,6 +11,7 @@ import (
 	"github.com/gruntwork-io/terragrunt/config"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/gruntwork-io/terragrunt/internal/experiment"
+	"github.com/gruntwork-io/terragrunt/internal/progress"
 	"github.com/gruntwork-io/terragrunt/options"
 	"github.com/gruntwork-io/terragrunt/util"
 )
@@ -19,7 +20,7 @@ const (
 	tempDirFormat = "catalog%x"
 )
 
-func Run(ctx context.Context, opts *options.TerragruntOptions, repoURL string) error {
+func Run(ctx context.Context, opts *options.TerragruntOptions, repoURL string) (err error) {
 	repoURLs := []string{repoURL}
 
 	if repoURL == "" {
@@ -35,31 +36,54 @@ func Run(ctx context.Context, opts *options.TerragruntOptions, repoURL string) e
 
 	repoURLs = util.RemoveDuplicatesFromList(repoURLs)
 
-	var modules module.Modules
+	if opts.ProgressJSON {
+		emitter := progress.NewEmitter(opts.ReserveWriterForJSON(), CommandName)
+		emitter.Start(len(repoURLs))
 
-	walkWithSymlinks := opts.Experiments.Evaluate(experiment.Symlinks)
+		defer func() { emitter.Done(err) }()
 
-	for _, repoURL := range repoURLs {
-		tempDir := filepath.Join(os.TempDir(), fmt.Sprintf(tempDirFormat, util.EncodeBase64Sha1(repoURL)))
+		ctx = progress.ContextWithEmitter(ctx, emitter)
+	}
 
-		repo, err := module.NewRepo(ctx, opts.Logger, repoURL, tempDir, walkWithSymlinks)
-		if err != nil {
-			return err
+	// The clones are removed once the user interface is closed.
+	coordinator := module.NewCloneCoordinator()
+	defer func() { err = errors.Join(err, coordinator.Close()) }()
+
+	modules, scanErr := module.ScanRepos(ctx, opts.Logger, repoURLs, module.ScanOptions{
+		TempDirFunc: func(repoURL string) string {
+			return filepath.Join(os.TempDir(), fmt.Sprintf(tempDirFormat, util.EncodeBase64Sha1(repoURL)))
+		},
+		CloneCoordinator: coordinator,
+		WalkWithSymlinks: opts.Experiments.Evaluate(experiment.Symlinks),
+		ContinueOnError:  opts.CatalogContinueOnError,
+	})
+	if scanErr != nil {
+		if len(modules) == 0 {
+			return scanErr
 		}
 
-		repoModules, err := repo.FindModules(ctx)
-		if err != nil {
-			return err
-		}
+		// The failed repositories are reported after the user interface is closed.
+		defer func() { err = errors.Join(err, scanErr) }()
+	}
 
-		opts.Logger.Infof("Found %d modules in repository %q", len(repoModules), repoURL)
+	duplicatePolicy := module.DuplicateFirstWins
+	if opts.CatalogDuplicateModules != "" {
+		duplicatePolicy = module.DuplicatePolicy(opts.CatalogDuplicateModules)
+	}
 
-		modules = append(modules, repoModules...)
+	if modules, err = modules.Dedup(duplicatePolicy); err != nil {
+		return err
 	}
 
 	if len(modules) == 0 {
 		return errors.Errorf("no modules found")
 	}
 
+	if dir := opts.CatalogDumpReadmesDir; dir != "" {
+		opts.Logger.Infof("Writing READMEs of %d modules to %q", len(modules), dir)
+
+		return modules.WriteReadmes(dir)
+	}
+
 	return tui.Run(ctx, modules, opts)
 }
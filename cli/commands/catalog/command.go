@@ -3,20 +3,60 @@
 package catalog
 
 import (
+	"slices"
+
+	"github.com/gruntwork-io/terragrunt/cli/commands/catalog/module"
 	"github.com/gruntwork-io/terragrunt/cli/commands/scaffold"
 	"github.com/gruntwork-io/terragrunt/cli/flags"
 	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/gruntwork-io/terragrunt/options"
 )
 
 const (
 	CommandName = "catalog"
+
+	validateCommandName = "validate"
+
+	DumpReadmesFlagName      = "dump-readmes"
+	DuplicateModulesFlagName = "duplicate-modules"
+	ContinueOnErrorFlagName  = "continue-on-error"
 )
 
 func NewFlags(opts *options.TerragruntOptions, prefix flags.Prefix) cli.Flags {
-	return scaffold.NewFlags(opts, prefix).Filter(
+	tgPrefix := prefix.Prepend(flags.TgPrefix)
+
+	return append(scaffold.NewFlags(opts, prefix).Filter(
 		scaffold.RootFileNameFlagName,
 		scaffold.NoIncludeRootFlagName,
+	),
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        DumpReadmesFlagName,
+			EnvVars:     tgPrefix.EnvVars(DumpReadmesFlagName),
+			Destination: &opts.CatalogDumpReadmesDir,
+			Usage:       "Write the README of each discovered module to <dir>/<module-path>/README.md, or README.adoc for AsciiDoc, instead of launching the user interface.",
+		}),
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:    DuplicateModulesFlagName,
+			EnvVars: tgPrefix.EnvVars(DuplicateModulesFlagName),
+			Usage:   "How to handle modules from different repositories that resolve to the same URL: 'first-wins' (default), 'keep-both' or 'error'.",
+			Setter: func(val string) error {
+				if !slices.Contains(module.DuplicatePolicies, module.DuplicatePolicy(val)) {
+					return errors.Errorf("unsupported duplicate modules policy %q, supported values: %s, %s, %s", val, module.DuplicateFirstWins, module.DuplicateKeepBoth, module.DuplicateError)
+				}
+
+				opts.CatalogDuplicateModules = val
+
+				return nil
+			},
+		}),
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        ContinueOnErrorFlagName,
+			EnvVars:     tgPrefix.EnvVars(ContinueOnErrorFlagName),
+			Destination: &opts.CatalogContinueOnError,
+			Usage:       "Skip the repositories that fail to clone or scan, and report their errors after the modules of the rest are found.",
+		}),
+		flags.NewProgressJSONFlag(opts, prefix),
 	)
 }
 
@@ -26,6 +66,16 @@ func NewCommand(opts *options.TerragruntOptions) *cli.Command {
 		Usage:                "Launch the user interface for searching and managing your module catalog.",
 		ErrorOnUndefinedFlag: true,
 		Flags:                NewFlags(opts, nil),
+		Subcommands: cli.Commands{
+			&cli.Command{
+				Name:      validateCommandName,
+				Usage:     "Check that the repository is reachable and print its default branch, without cloning it.",
+				UsageText: "terragrunt catalog validate <repo-url>",
+				Action: func(ctx *cli.Context) error {
+					return RunValidate(ctx, opts.OptionsFromContext(ctx), ctx.Args().Get(0))
+				},
+			},
+		},
 		Action: func(ctx *cli.Context) error {
 			var repoPath string
 
@@ -0,0 +1,24 @@
+package catalog
+
+import "fmt"
+
+// MissingRepoURLError is returned when a command requires a repository URL but none is given.
+type MissingRepoURLError struct{}
+
+func (err MissingRepoURLError) Error() string {
+	return "repository URL is required"
+}
+
+// RepoNotReachableError is returned when the remote repository cannot be reached.
+type RepoNotReachableError struct {
+	URL string
+	Err error
+}
+
+func (err RepoNotReachableError) Error() string {
+	return fmt.Sprintf("repository %q is not reachable: %v", err.URL, err.Err)
+}
+
+func (err RepoNotReachableError) Unwrap() error {
+	return err.Err
+}
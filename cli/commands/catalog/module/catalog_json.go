@@ -0,0 +1,88 @@
+package module
+
+import (
+	"encoding/json"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+)
+
+// CatalogJSONSchemaVersion is the version of the `CatalogJSON` schema, it is incremented on incompatible changes.
+const CatalogJSONSchemaVersion = 1
+
+// CatalogJSON is the JSON representation of the discovered modules, for tooling that builds its own catalog frontend.
+type CatalogJSON struct {
+	SchemaVersion int                 `json:"schema_version"`
+	Modules       []CatalogJSONModule `json:"modules"`
+}
+
+// CatalogJSONModule is the JSON representation of a module. If the module URL cannot be resolved,
+// the error is set in `Error` instead of failing the whole catalog.
+type CatalogJSONModule struct {
+	Path         string       `json:"path"`
+	Title        string       `json:"title"`
+	Description  string       `json:"description,omitempty"`
+	URL          string       `json:"url,omitempty"`
+	Source       string       `json:"source"`
+	ReadmeFormat ReadmeFormat `json:"readme_format"`
+	Tags         []string     `json:"tags,omitempty"`
+	Group        string       `json:"group,omitempty"`
+	Error        string       `json:"error,omitempty"`
+}
+
+// ToCatalogJSON returns the JSON representation of the modules, sorted by their sources.
+func (modules Modules) ToCatalogJSON() *CatalogJSON {
+	catalog := &CatalogJSON{
+		SchemaVersion: CatalogJSONSchemaVersion,
+		Modules:       make([]CatalogJSONModule, 0, len(modules)),
+	}
+
+	for _, module := range modules {
+		jsonModule := CatalogJSONModule{
+			Path:         module.moduleDir,
+			Title:        module.Title(),
+			Description:  module.Doc.Description(0),
+			Source:       module.TerraformSourcePath(),
+			ReadmeFormat: module.ReadmeFormat(),
+			Tags:         module.Tags(),
+			Group:        module.Group(),
+		}
+
+		if url, err := module.resolveURL(); err != nil {
+			jsonModule.Error = err.Error()
+		} else {
+			jsonModule.URL = url
+		}
+
+		catalog.Modules = append(catalog.Modules, jsonModule)
+	}
+
+	slices.SortStableFunc(catalog.Modules, func(a, b CatalogJSONModule) int {
+		return strings.Compare(a.Source, b.Source)
+	})
+
+	return catalog
+}
+
+// WriteCatalogJSON writes the JSON representation of the modules to the given writer `w`.
+func (modules Modules) WriteCatalogJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(modules.ToCatalogJSON()); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+// resolveURL returns the module URL resolved by `NewModule`, or resolves it from the repository if it is not set.
+func (module *Module) resolveURL() (string, error) {
+	if module.url != "" || module.Repo == nil {
+		return module.url, nil
+	}
+
+	return module.Repo.ModuleURL(module.moduleDir)
+}
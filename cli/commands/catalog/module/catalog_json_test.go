@@ -0,0 +1,66 @@
+package module_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/cli/commands/catalog/module"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModulesWriteCatalogJSON(t *testing.T) {
+	t.Parallel()
+
+	const cloneURL = "https://github.com/acme/terraform-aws-modules.git"
+
+	files := map[string]string{
+		"modules/vpc/main.tf": "",
+		"modules/vpc/README.md": "<!-- Frontmatter\n" +
+			"name: AWS VPC\n" +
+			"description: Creates a VPC with public and private subnets.\n" +
+			"-->\n\n# VPC\n",
+		"modules/vpc/catalog-module.hcl": `tags = ["network"]`,
+		"modules/rds/main.tf":            "",
+		"modules/rds/README.adoc":        "= RDS\n\nCreates an RDS database.\n",
+	}
+
+	fakeGetter := func(_ context.Context, dst, _ string) error {
+		for name, content := range files {
+			path := filepath.Join(dst, filepath.FromSlash(name))
+
+			if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+				return err
+			}
+
+			if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+				return err
+			}
+		}
+
+		return writeGitDir(t, dst, cloneURL)
+	}
+
+	repo, err := module.NewRepo(context.Background(), log.New(), cloneURL, t.TempDir(), false, module.WithGetter(fakeGetter))
+	require.NoError(t, err)
+
+	modules, err := repo.FindModules(context.Background())
+	require.NoError(t, err)
+	require.Len(t, modules, 2)
+
+	var buf bytes.Buffer
+
+	require.NoError(t, modules.WriteCatalogJSON(&buf))
+
+	goldenPath := filepath.Join("testdata", "catalog_json", "catalog.golden.json")
+
+	expected, err := os.ReadFile(goldenPath)
+	require.NoError(t, err)
+
+	assert.Equal(t, strings.TrimSpace(string(expected)), strings.TrimSpace(buf.String()))
+}
@@ -0,0 +1,78 @@
+package module
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+)
+
+// ChangedModules returns the directories of the modules, relative to the repository root, that have changes between the
+// `baseRef` and `headRef` commits, in the same form as `ListModulePaths`. A changed file marks the closest module directory
+// containing it as changed, including files in subdirectories of a module, e.g. `modules/vpc/files/policy.json`.
+// The module directories are taken from the cloned working tree, so modules removed between the refs are not reported.
+// If some of the modules cannot be discovered, the changed modules among the rest are returned along with a `*PartialDiscoveryError`.
+func (repo *Repo) ChangedModules(ctx context.Context, baseRef, headRef string) ([]string, error) {
+	changedFiles, err := repo.diffFiles(ctx, baseRef, headRef)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleDirs, discoveryErr := repo.ListModulePaths(ctx)
+	if discoveryErr != nil && !errors.As(discoveryErr, new(*PartialDiscoveryError)) {
+		return nil, discoveryErr
+	}
+
+	var changedDirs []string
+
+	for _, file := range changedFiles {
+		if moduleDir, ok := closestModuleDir(moduleDirs, file); ok && !slices.Contains(changedDirs, moduleDir) {
+			changedDirs = append(changedDirs, moduleDir)
+		}
+	}
+
+	slices.Sort(changedDirs)
+
+	return changedDirs, discoveryErr
+}
+
+// diffFiles returns the paths, relative to the repository root, of the files that differ between the given refs.
+// Renames are reported as a deletion and an addition, so that both the old and the new paths are returned.
+func (repo *Repo) diffFiles(ctx context.Context, baseRef, headRef string) ([]string, error) {
+	output, err := repo.runGit(ctx, "diff", "--name-only", "--no-renames", "-z", baseRef, headRef, "--")
+	if err != nil {
+		return nil, errors.Errorf("diff %s %s: %w", baseRef, headRef, err)
+	}
+
+	var files []string
+
+	for _, file := range strings.Split(output, "\x00") {
+		if file != "" {
+			files = append(files, file)
+		}
+	}
+
+	return files, nil
+}
+
+// closestModuleDir returns the deepest of the module directories `moduleDirs` containing the given slash-separated `file` path.
+func closestModuleDir(moduleDirs []string, file string) (string, bool) {
+	var (
+		closest string
+		found   bool
+	)
+
+	for _, moduleDir := range moduleDirs {
+		if dir := filepath.ToSlash(moduleDir); dir != "" && !strings.HasPrefix(file, dir+"/") {
+			continue
+		}
+
+		if !found || len(moduleDir) > len(closest) {
+			closest, found = moduleDir, true
+		}
+	}
+
+	return closest, found
+}
@@ -0,0 +1,95 @@
+package module
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	"github.com/gruntwork-io/go-commons/files"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+)
+
+const (
+	gitShallowFileName = "shallow"
+	gitFetchHead       = "FETCH_HEAD"
+	gitLocalBranchRef  = "refs/heads/"
+)
+
+// Checkout switches the working tree of the repository to the given ref, a branch, tag, or commit SHA, without cloning it again,
+// e.g. to present another version of the modules. If the ref is not in the clone, it is fetched from the remote first,
+// with depth 1 if the clone is shallow. Local branches are checked out as is, other refs are checked out as a detached HEAD.
+// `BranchName` is updated to the checked out ref. Returns an error wrapping `ErrCheckout` if the ref cannot be fetched or checked out.
+func (repo *Repo) Checkout(ctx context.Context, ref string) error {
+	if !files.FileExists(repo.gitHeadfile()) {
+		return errors.Errorf("%w: the specified path %q is %w", ErrCheckout, repo.path, ErrNotGitRepo)
+	}
+
+	// a ref must not be taken for a git option
+	if ref == "" || strings.HasPrefix(ref, "-") {
+		return errors.Errorf("%w: invalid ref %q", ErrCheckout, ref)
+	}
+
+	checkoutArgs := []string{"checkout", "--quiet", ref}
+
+	switch {
+	case repo.hasGitRef(ctx, gitLocalBranchRef+ref):
+	case repo.hasGitRef(ctx, ref+"^{commit}"):
+		checkoutArgs = []string{"checkout", "--quiet", "--detach", ref}
+	default:
+		if err := repo.fetchRef(ctx, ref); err != nil {
+			return err
+		}
+
+		checkoutArgs = []string{"checkout", "--quiet", "--detach", gitFetchHead}
+	}
+
+	if _, err := repo.runGit(ctx, checkoutArgs...); err != nil {
+		return errors.Errorf("%w: %q: %w", ErrCheckout, ref, err)
+	}
+
+	if err := repo.parseBranchName(); err != nil {
+		return err
+	}
+
+	// a detached HEAD contains the commit SHA, the requested ref is more meaningful, e.g. for the module URLs of a tag
+	if sha, err := repo.CommitSHA(); err == nil && repo.BranchName == sha {
+		repo.BranchName = ref
+	}
+
+	// the modules found before belong to the previous ref
+	repo.foundModules = nil
+
+	repo.logger.Debugf("Checked out ref %q in repo %q", ref, repo.path)
+
+	return nil
+}
+
+// fetchRef fetches the given ref from the remote, with depth 1 if the clone is shallow.
+func (repo *Repo) fetchRef(ctx context.Context, ref string) error {
+	if repo.RemoteURL == "" {
+		return errors.Errorf("%w: ref %q not found and the repository %q has no remote to fetch it from", ErrCheckout, ref, repo.path)
+	}
+
+	args := []string{"fetch", "--quiet"}
+
+	if files.FileExists(filepath.Join(repo.path, gitDirName, gitShallowFileName)) {
+		args = append(args, "--depth=1")
+	}
+
+	args = append(args, repo.RemoteURL, ref)
+
+	repo.logger.Debugf("Fetching ref %q from %q", ref, repo.RemoteURL)
+
+	if _, err := repo.runGit(ctx, args...); err != nil {
+		return errors.Errorf("%w: fetch %q: %w", ErrCheckout, ref, err)
+	}
+
+	return nil
+}
+
+// hasGitRef returns true if the given revision can be resolved in the clone.
+func (repo *Repo) hasGitRef(ctx context.Context, rev string) bool {
+	_, err := repo.runGit(ctx, "rev-parse", "--verify", "--quiet", rev)
+
+	return err == nil
+}
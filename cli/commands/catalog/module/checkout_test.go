@@ -0,0 +1,192 @@
+package module_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/cli/commands/catalog/module"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoCheckout(t *testing.T) {
+	t.Parallel()
+
+	srcDir := t.TempDir()
+
+	git := func(dir string, args ...string) {
+		t.Helper()
+
+		args = append([]string{"-C", dir, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)
+
+		output, err := exec.Command("git", args...).CombinedOutput()
+		require.NoError(t, err, string(output))
+	}
+
+	writeModule := func(name string) {
+		t.Helper()
+
+		require.NoError(t, os.MkdirAll(filepath.Join(srcDir, "modules", name), os.ModePerm))
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "modules", name, "main.tf"), []byte{}, 0644))
+	}
+
+	git(srcDir, "init", "--quiet", "--initial-branch=main")
+	writeModule("vpc")
+	git(srcDir, "add", ".")
+	git(srcDir, "commit", "--quiet", "-m", "vpc")
+	git(srcDir, "tag", "v1.0.0")
+	writeModule("eks")
+	git(srcDir, "add", ".")
+	git(srcDir, "commit", "--quiet", "-m", "eks")
+	git(srcDir, "checkout", "--quiet", "-b", "release")
+	writeModule("rds")
+	git(srcDir, "add", ".")
+	git(srcDir, "commit", "--quiet", "-m", "rds")
+	git(srcDir, "checkout", "--quiet", "main")
+
+	newRepo := func(t *testing.T) *module.Repo {
+		t.Helper()
+
+		repo, err := module.NewRepo(context.Background(), log.New(), "git::file://"+filepath.ToSlash(srcDir), t.TempDir(), false)
+		require.NoError(t, err)
+
+		t.Cleanup(func() { require.NoError(t, repo.Close()) })
+
+		return repo
+	}
+
+	t.Run("tag", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+		assert.Equal(t, "main", repo.BranchName)
+
+		paths, err := repo.ListModulePaths(context.Background())
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"modules/eks", "modules/vpc"}, paths)
+
+		require.NoError(t, repo.Checkout(context.Background(), "v1.0.0"))
+		assert.Equal(t, "v1.0.0", repo.BranchName)
+
+		paths, err = repo.ListModulePaths(context.Background())
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"modules/vpc"}, paths)
+
+		require.NoError(t, repo.Checkout(context.Background(), "main"))
+		assert.Equal(t, "main", repo.BranchName)
+	})
+
+	t.Run("remote branch", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		require.NoError(t, repo.Checkout(context.Background(), "release"))
+		assert.Equal(t, "release", repo.BranchName)
+
+		paths, err := repo.ListModulePaths(context.Background())
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"modules/eks", "modules/rds", "modules/vpc"}, paths)
+	})
+
+	t.Run("nonexistent ref", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		err := repo.Checkout(context.Background(), "does-not-exist")
+		require.ErrorIs(t, err, module.ErrCheckout)
+		assert.Equal(t, "main", repo.BranchName)
+	})
+
+	t.Run("option-like ref", func(t *testing.T) {
+		t.Parallel()
+
+		err := newRepo(t).Checkout(context.Background(), "--orphan")
+		require.ErrorIs(t, err, module.ErrCheckout)
+	})
+}
+
+// The test is not parallel, since it replaces git in PATH with a script logging the args.
+func TestRepoCheckoutUserAgent(t *testing.T) {
+	gitPath, err := exec.LookPath("git")
+	require.NoError(t, err)
+
+	srcDir := t.TempDir()
+
+	for _, args := range [][]string{
+		{"init", "--quiet", "--initial-branch=main"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "--allow-empty", "-m", "first"},
+		{"tag", "v1.0.0"},
+	} {
+		output, err := exec.Command(gitPath, append([]string{"-C", srcDir}, args...)...).CombinedOutput()
+		require.NoError(t, err, string(output))
+	}
+
+	repo, err := module.NewRepo(context.Background(), log.New(), "git::file://"+filepath.ToSlash(srcDir), t.TempDir(), false,
+		module.WithUserAgent("acme-catalog/1.0"))
+	require.NoError(t, err)
+
+	t.Cleanup(func() { require.NoError(t, repo.Close()) })
+
+	binDir := t.TempDir()
+	argsLog := filepath.Join(binDir, "args.log")
+
+	script := "#!/bin/sh\necho \"$@\" >> " + argsLog + "\nexec " + gitPath + " \"$@\"\n"
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "git"), []byte(script), 0755))
+
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	require.NoError(t, repo.Checkout(context.Background(), "v1.0.0"))
+
+	args, err := os.ReadFile(argsLog)
+	require.NoError(t, err)
+	assert.Contains(t, string(args), "-c http.userAgent=acme-catalog/1.0")
+}
+
+func TestRepoCheckoutProxy(t *testing.T) {
+	t.Parallel()
+
+	srcDir := t.TempDir()
+
+	for _, args := range [][]string{
+		{"init", "--quiet", "--initial-branch=main"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "--allow-empty", "-m", "first"},
+		{"remote", "add", "origin", "http://catalog.example.test/acme/terraform-aws-modules.git"},
+	} {
+		output, err := exec.Command("git", append([]string{"-C", srcDir}, args...)...).CombinedOutput()
+		require.NoError(t, err, string(output))
+	}
+
+	proxiedURLs := make(chan string, 1)
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case proxiedURLs <- r.URL.String():
+		default:
+		}
+
+		http.NotFound(w, r)
+	}))
+	defer proxy.Close()
+
+	repo, err := module.NewRepo(context.Background(), log.New(), srcDir, "", false, module.WithProxy(proxy.URL, "", "", ""))
+	require.NoError(t, err)
+
+	// the ref is not in the clone, so it is fetched from the remote through the proxy
+	err = repo.Checkout(context.Background(), "v1.0.0")
+	require.ErrorIs(t, err, module.ErrCheckout)
+
+	select {
+	case proxiedURL := <-proxiedURLs:
+		assert.Contains(t, proxiedURL, "http://catalog.example.test/acme/terraform-aws-modules.git/")
+	default:
+		t.Fatal("no request received by the proxy")
+	}
+}
@@ -0,0 +1,60 @@
+package module
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+)
+
+// cloneMetadata is stored in the clone sentinel file. It records what was cloned, so that the next clone
+// into the same directory can update it in place with `git fetch`, instead of cloning from scratch.
+type cloneMetadata struct {
+	ClonedAt time.Time `json:"cloned_at"`
+	CloneURL string    `json:"clone_url"`
+	Ref      string    `json:"ref"`
+}
+
+// writeCloneSentinel marks the clone as completed, recording the URL and the ref it was cloned from.
+func (repo *Repo) writeCloneSentinel(ref string) error {
+	data, err := json.Marshal(&cloneMetadata{
+		ClonedAt: time.Now().UTC(),
+		CloneURL: repo.redact(repo.cloneURL),
+		Ref:      ref,
+	})
+	if err != nil {
+		return errors.New(err)
+	}
+
+	if err := os.WriteFile(repo.cloneSentinelFile(), data, cloneCompleteSentinelFileMode); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+// readCloneSentinel returns the metadata of the completed clone, or nil if the clone is not completed,
+// or was made by an older version that did not record the metadata.
+func (repo *Repo) readCloneSentinel() *cloneMetadata {
+	data, err := os.ReadFile(repo.cloneSentinelFile())
+	if err != nil {
+		return nil
+	}
+
+	metadata := new(cloneMetadata)
+
+	if err := json.Unmarshal(data, metadata); err != nil {
+		return nil
+	}
+
+	return metadata
+}
+
+// canUpdateClone returns true if the repo dir contains a completed clone of the same URL and ref,
+// which `go-getter` can update in place by fetching only the new objects.
+func (repo *Repo) canUpdateClone(ref string) bool {
+	metadata := repo.readCloneSentinel()
+
+	return metadata != nil && metadata.CloneURL == repo.redact(repo.cloneURL) && metadata.Ref == ref
+}
@@ -0,0 +1,72 @@
+package module
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/gruntwork-io/go-commons/files"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/tf"
+)
+
+// NormalizeCloneURL returns the canonical form of the given clone URL, so that the different forms of the same repository URL compare equal.
+// The forced getter, such as `git::`, the userinfo, the query, the `.git` suffix and trailing slashes are dropped, and the scheme and host are lowercased.
+// The SCP-like SSH form, such as `git@github.com:org/repo.git`, is converted to `ssh://github.com/org/repo`. The subdirectory
+// after `//` is kept. Local directories, including `file://` URLs, are normalized to their absolute paths.
+func NormalizeCloneURL(rawURL string) (string, error) {
+	if strings.TrimSpace(rawURL) == "" {
+		return "", errors.New("clone URL is empty")
+	}
+
+	if localPath, ok := strings.CutPrefix(rawURL, fileURLPrefix); ok && files.IsDir(localPath) {
+		rawURL = localPath
+	}
+
+	if files.IsDir(rawURL) {
+		absPath, err := filepath.Abs(rawURL)
+		if err != nil {
+			return "", errors.New(err)
+		}
+
+		return absPath, nil
+	}
+
+	sourceURL, err := tf.ToSourceURL(rawURL, "")
+	if err != nil {
+		return "", err
+	}
+
+	_, scheme, _ := strings.Cut(sourceURL.Scheme, "::")
+	if scheme == "" {
+		scheme = sourceURL.Scheme
+	}
+
+	repoPath, subDir, hasSubDir := strings.Cut(sourceURL.Path, "//")
+	repoPath = strings.TrimSuffix(strings.TrimRight(repoPath, "/"), ".git")
+
+	if subDir = strings.Trim(subDir, "/"); hasSubDir && subDir != "" {
+		repoPath += "//" + subDir
+	}
+
+	return strings.ToLower(scheme) + "://" + strings.ToLower(sourceURL.Host) + repoPath, nil
+}
+
+// redactedUserinfo replaces the userinfo of the clone URL in the logs and errors.
+const redactedUserinfo = "xxxxx"
+
+// secretUserinfo returns the userinfo of the given `cloneURL` if it may contain credentials, i.e. it has a password,
+// or it was expanded from the env vars referenced in the URL template `rawCloneURL`. Otherwise, an empty string is returned.
+func secretUserinfo(rawCloneURL, cloneURL string) string {
+	sourceURL, err := tf.ToSourceURL(cloneURL, "")
+	if err != nil || sourceURL.User == nil {
+		return ""
+	}
+
+	userinfo := sourceURL.User.String()
+
+	if _, ok := sourceURL.User.Password(); ok || !strings.Contains(rawCloneURL, userinfo+"@") {
+		return userinfo
+	}
+
+	return ""
+}
@@ -0,0 +1,108 @@
+package module
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/tf"
+)
+
+// CloneCoordinator shares the repository clones between the references to the same repository within a run,
+// so that a repository referenced by several URLs, e.g. with and without the `.git` suffix, is cloned only once.
+// Repositories are keyed by the normalized clone URL and the ref. It is safe for concurrent use.
+type CloneCoordinator struct {
+	repos map[string]*coordinatedRepo
+	mu    sync.Mutex
+}
+
+type coordinatedRepo struct {
+	repo *Repo
+	err  error
+	once sync.Once
+}
+
+// NewCloneCoordinator returns a new `CloneCoordinator` instance.
+func NewCloneCoordinator() *CloneCoordinator {
+	return &CloneCoordinator{
+		repos: make(map[string]*coordinatedRepo),
+	}
+}
+
+// Repo returns the repository shared by all the references to the given `cloneURL` and `ref`. The first call clones the repository
+// with `NewRepo` and the given arguments, the subsequent calls return the same `*Repo`, or the same error if the clone failed.
+// Concurrent calls for the same repository wait for the clone to complete. If `ref` is empty, the `ref` query parameter of the URL
+// or the remote `HEAD` is cloned. The ref must be passed as `ref` rather than with `WithRef` in `opts`, since it is part of the key.
+func (coordinator *CloneCoordinator) Repo(ctx context.Context, logger log.Logger, cloneURL, ref, tempDir string, walkWithSymlinks bool, opts ...Option) (*Repo, error) {
+	key := cloneKey(cloneURL, ref)
+
+	if ref != "" {
+		opts = append(slices.Clone(opts), WithRef(ref))
+	}
+
+	coordinator.mu.Lock()
+
+	entry, ok := coordinator.repos[key]
+	if !ok {
+		entry = new(coordinatedRepo)
+		coordinator.repos[key] = entry
+	}
+
+	coordinator.mu.Unlock()
+
+	if ok {
+		logger.Debugf("Reusing the clone of repository %q for %q", key, cloneURL)
+	}
+
+	entry.once.Do(func() {
+		entry.repo, entry.err = NewRepo(ctx, logger, cloneURL, tempDir, walkWithSymlinks, opts...)
+	})
+
+	return entry.repo, entry.err
+}
+
+// Close closes all the repositories cloned by the coordinator.
+func (coordinator *CloneCoordinator) Close() error {
+	coordinator.mu.Lock()
+	defer coordinator.mu.Unlock()
+
+	var errs *errors.MultiError
+
+	for _, entry := range coordinator.repos {
+		if entry.repo != nil {
+			if err := entry.repo.Close(); err != nil {
+				errs = errs.Append(err)
+			}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// cloneKey returns the normalized form of the given `cloneURL`, see `NormalizeCloneURL`, along with the ref, in the form `<url>@<ref>`.
+// The ref is taken from the `ref` query parameter of the URL, unless `ref` is set. Local directories are keyed by their absolute paths.
+// The env var references in the URL are expanded.
+func cloneKey(cloneURL, ref string) string {
+	if expandedURL, err := expandCloneURL(cloneURL); err == nil {
+		cloneURL = expandedURL
+	}
+
+	normalizedURL, err := NormalizeCloneURL(cloneURL)
+	if err != nil {
+		return strings.TrimSuffix(strings.TrimRight(cloneURL, "/"), ".git")
+	}
+
+	if filepath.IsAbs(normalizedURL) {
+		return normalizedURL
+	}
+
+	if sourceURL, err := tf.ToSourceURL(cloneURL, ""); err == nil {
+		ref = resolveRef(ref, sourceURL)
+	}
+
+	return normalizedURL + "@" + ref
+}
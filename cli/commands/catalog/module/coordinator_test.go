@@ -0,0 +1,152 @@
+package module_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/cli/commands/catalog/module"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloneCoordinator(t *testing.T) {
+	t.Parallel()
+
+	repoURLs := []string{
+		"https://github.com/acme/terraform-aws-modules",
+		"https://github.com/acme/terraform-aws-modules.git",
+		"git::https://github.com/acme/terraform-aws-modules.git/",
+	}
+
+	newCountingGetter := func(t *testing.T) (module.GetterFunc, *atomic.Int32) {
+		t.Helper()
+
+		var clones atomic.Int32
+
+		return func(_ context.Context, dst, src string) error {
+			clones.Add(1)
+
+			if err := os.MkdirAll(filepath.Join(dst, "modules", "vpc"), os.ModePerm); err != nil {
+				return err
+			}
+
+			if err := os.WriteFile(filepath.Join(dst, "modules", "vpc", "main.tf"), []byte{}, 0644); err != nil {
+				return err
+			}
+
+			remoteURL, _, _ := strings.Cut(strings.TrimPrefix(src, "git::"), "?")
+
+			return writeGitDir(t, dst, remoteURL)
+		}, &clones
+	}
+
+	t.Run("sequential", func(t *testing.T) {
+		t.Parallel()
+
+		getter, clones := newCountingGetter(t)
+		coordinator := module.NewCloneCoordinator()
+		tempDir := t.TempDir()
+
+		var repos []*module.Repo
+
+		for _, repoURL := range repoURLs {
+			repo, err := coordinator.Repo(context.Background(), log.New(), repoURL, "", tempDir, false, module.WithGetter(getter))
+			require.NoError(t, err)
+
+			repos = append(repos, repo)
+		}
+
+		assert.Equal(t, int32(1), clones.Load())
+		assert.Same(t, repos[0], repos[1])
+		assert.Same(t, repos[0], repos[2])
+
+		require.NoError(t, coordinator.Close())
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		t.Parallel()
+
+		getter, clones := newCountingGetter(t)
+		coordinator := module.NewCloneCoordinator()
+		tempDir := t.TempDir()
+
+		var wg sync.WaitGroup
+
+		repos := make([]*module.Repo, len(repoURLs))
+		errs := make([]error, len(repoURLs))
+
+		for i, repoURL := range repoURLs {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				repos[i], errs[i] = coordinator.Repo(context.Background(), log.New(), repoURL, "", tempDir, false, module.WithGetter(getter))
+			}()
+		}
+
+		wg.Wait()
+
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, int32(1), clones.Load())
+		assert.Same(t, repos[0], repos[1])
+		assert.Same(t, repos[0], repos[2])
+	})
+
+	t.Run("scan", func(t *testing.T) {
+		t.Parallel()
+
+		getter, clones := newCountingGetter(t)
+		tempDir := t.TempDir()
+
+		modules, err := module.ScanRepos(context.Background(), log.New(), repoURLs, module.ScanOptions{
+			TempDirFunc: func(repoURL string) string { return filepath.Join(tempDir, strings.ReplaceAll(repoURL, "/", "_")) },
+			RepoOptions: []module.Option{module.WithGetter(getter)},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(1), clones.Load())
+		assert.Len(t, modules, 1)
+	})
+
+	t.Run("different refs", func(t *testing.T) {
+		t.Parallel()
+
+		getter, clones := newCountingGetter(t)
+		coordinator := module.NewCloneCoordinator()
+
+		first, err := coordinator.Repo(context.Background(), log.New(), repoURLs[0]+"?ref=v1.0.0", "", t.TempDir(), false, module.WithGetter(getter))
+		require.NoError(t, err)
+
+		second, err := coordinator.Repo(context.Background(), log.New(), repoURLs[1], "v2.0.0", t.TempDir(), false, module.WithGetter(getter))
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(2), clones.Load())
+		assert.NotSame(t, first, second)
+	})
+
+	t.Run("same ref from URL and argument", func(t *testing.T) {
+		t.Parallel()
+
+		getter, clones := newCountingGetter(t)
+		coordinator := module.NewCloneCoordinator()
+
+		first, err := coordinator.Repo(context.Background(), log.New(), repoURLs[0]+"?ref=v1.0.0", "", t.TempDir(), false, module.WithGetter(getter))
+		require.NoError(t, err)
+
+		second, err := coordinator.Repo(context.Background(), log.New(), repoURLs[1], "v1.0.0", t.TempDir(), false, module.WithGetter(getter))
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(1), clones.Load())
+		assert.Same(t, first, second)
+	})
+}
@@ -1,18 +1,26 @@
 package module
 
 import (
+	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
 	"strings"
 
 	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"gopkg.in/yaml.v3"
 )
 
 const (
 	mdExt   = ".md"
 	adocExt = ".adoc"
 
+	ReadmeFormatNone      ReadmeFormat = "none"
+	ReadmeFormatMarkdown  ReadmeFormat = "markdown"
+	ReadmeFormatAsciiDoc  ReadmeFormat = "asciidoc"
+	ReadmeFormatPlainText ReadmeFormat = "text"
+
 	docTitle docDataKey = iota
 	docDescription
 	docContent
@@ -22,18 +30,63 @@ const (
 )
 
 var (
-	// `strings.EqualFold` is used (case insensitive) while comparing
-	docFiles = []string{"README.md", "README.adoc"}
+	// `strings.EqualFold` is used (case insensitive) while comparing, the files are listed in order of priority.
+	docFiles = []string{"README.md", "README.markdown", "README.adoc", "README.asciidoc", "README.txt", "README"}
+
+	readmeFormatsByExt = map[string]ReadmeFormat{
+		".md":       ReadmeFormatMarkdown,
+		".markdown": ReadmeFormatMarkdown,
+		".adoc":     ReadmeFormatAsciiDoc,
+		".asciidoc": ReadmeFormatAsciiDoc,
+	}
+
+	asciiDocSniffReg = regexp.MustCompile(`(?m)^(={1,6}\s+\S|:[-!\w]+:|\w+::\[.*?\])`)
+	markdownSniffReg = regexp.MustCompile(`(?m)^(#{1,6}\s+\S|` + "`{3}" + `|[=\-]{3,}\s*$)|\[[^\]]+\]\([^)]+\)`)
 
 	frontmatterKeys = map[string]docDataKey{
 		"name":        docTitle,
+		"title":       docTitle,
 		"description": docDescription,
 	}
+
+	// yamlFrontmatterReg matches the YAML front matter delimited by `---` lines at the top of the document.
+	yamlFrontmatterReg = regexp.MustCompile(`^[\s\n]*---[ \t]*\r?\n([\S\s]*?)\r?\n---[ \t]*(?:\r?\n|$)`)
 )
 
 type docDataKey byte
 type docTagName byte
 
+// ReadmeFormat is the markup format of the module README file.
+type ReadmeFormat string
+
+// DetectReadmeFormat detects the README format by the file extension, falling back to sniffing the content if the extension is unknown.
+func DetectReadmeFormat(fileExt, content string) ReadmeFormat {
+	if format, ok := readmeFormatsByExt[strings.ToLower(fileExt)]; ok {
+		return format
+	}
+
+	switch {
+	case asciiDocSniffReg.MatchString(content):
+		return ReadmeFormatAsciiDoc
+	case markdownSniffReg.MatchString(content):
+		return ReadmeFormatMarkdown
+	}
+
+	return ReadmeFormatPlainText
+}
+
+// fileExt returns the file extension used to parse the documents of the format.
+func (format ReadmeFormat) fileExt() string {
+	switch format {
+	case ReadmeFormatMarkdown:
+		return mdExt
+	case ReadmeFormatAsciiDoc:
+		return adocExt
+	}
+
+	return ""
+}
+
 type DocRegs []*regexp.Regexp
 
 func (regs DocRegs) Replace(str string) string {
@@ -47,6 +100,8 @@ func (regs DocRegs) Replace(str string) string {
 type Doc struct {
 	rawContent string
 	fileExt    string
+	filePath   string
+	format     ReadmeFormat
 
 	tagCache     map[docDataKey]string
 	tagRegs      map[docTagName]*regexp.Regexp
@@ -54,6 +109,7 @@ type Doc struct {
 
 	frontmatterCache map[docDataKey]string
 	frontmatterReg   *regexp.Regexp
+	frontmatterErr   error
 }
 
 func NewDoc(rawContent, fileExt string) *Doc {
@@ -133,10 +189,19 @@ func NewDoc(rawContent, fileExt string) *Doc {
 	return doc
 }
 
+// FindDoc returns the README document in the given `dir`, or an empty document if there is no README.
 func FindDoc(dir string) (*Doc, error) {
-	var filePath, fileExt string
+	return findDoc(os.DirFS(dir), dir, ".")
+}
 
-	files, err := os.ReadDir(dir)
+// findDoc returns the README document in the given `dir` of `fsys`, which is rooted at the `root` dir.
+func findDoc(fsys fs.FS, root, dir string) (*Doc, error) {
+	var (
+		filePath string
+		priority = len(docFiles)
+	)
+
+	files, err := fs.ReadDir(fsys, dir)
 	if err != nil {
 		return nil, errors.New(err)
 	}
@@ -146,33 +211,33 @@ func FindDoc(dir string) (*Doc, error) {
 			continue
 		}
 
-		for _, readmeFile := range docFiles {
+		for i, readmeFile := range docFiles[:priority] {
 			if strings.EqualFold(readmeFile, file.Name()) {
-				filePath = filepath.Join(dir, file.Name())
-				fileExt = filepath.Ext(filePath)
+				filePath = path.Join(dir, file.Name())
+				priority = i
 
 				break
 			}
 		}
-
-		// `md` files have priority over `adoc` files
-		if strings.EqualFold(fileExt, mdExt) {
-			break
-		}
 	}
 
 	if filePath == "" {
-		return &Doc{}, nil
+		return &Doc{format: ReadmeFormatNone}, nil
 	}
 
-	contentByte, err := os.ReadFile(filePath)
+	contentByte, err := fs.ReadFile(fsys, filePath)
 	if err != nil {
 		return nil, errors.New(err)
 	}
 
 	rawContent := string(contentByte)
+	format := DetectReadmeFormat(filepath.Ext(filePath), rawContent)
+
+	doc := NewDoc(rawContent, format.fileExt())
+	doc.filePath = filepath.Join(root, filepath.FromSlash(filePath))
+	doc.format = format
 
-	return NewDoc(rawContent, fileExt), nil
+	return doc, nil
 }
 
 func (doc *Doc) Title() string {
@@ -218,6 +283,7 @@ func (doc *Doc) Description(maxLenght int) string {
 	return desc
 }
 
+// Content returns the raw document content, or the content without the YAML front matter and with the tags stripped if `stripTags` is true.
 func (doc *Doc) Content(stripTags bool) string {
 	if !stripTags {
 		return doc.rawContent
@@ -226,11 +292,49 @@ func (doc *Doc) Content(stripTags bool) string {
 	return doc.parseTag(docContent)
 }
 
+// Body returns the raw document content without the YAML front matter.
+func (doc *Doc) Body() string {
+	return yamlFrontmatterReg.ReplaceAllString(doc.rawContent, "")
+}
+
+// FrontmatterError returns the error of parsing the malformed YAML front matter, which is otherwise ignored,
+// so that the title and the description are taken from the document body.
+func (doc *Doc) FrontmatterError() error {
+	doc.parseFrontmatter(docTitle)
+
+	return doc.frontmatterErr
+}
+
 func (doc *Doc) IsMarkDown() bool {
 	return doc.fileExt == mdExt
 }
 
-// parseFrontmatter parses Markdown files with frontmatter, which we use as the preferred title/description source.
+// FilePath returns the path of the document file, or an empty string if the document was not read from a file.
+func (doc *Doc) FilePath() string {
+	return doc.filePath
+}
+
+// Format returns the detected document format, `ReadmeFormatNone` if there is no document.
+func (doc *Doc) Format() ReadmeFormat {
+	if doc.format != "" {
+		return doc.format
+	}
+
+	if doc.rawContent == "" {
+		return ReadmeFormatNone
+	}
+
+	return DetectReadmeFormat(doc.fileExt, doc.rawContent)
+}
+
+// Bytes returns the raw content of the document.
+func (doc *Doc) Bytes() []byte {
+	return []byte(doc.rawContent)
+}
+
+// parseFrontmatter parses the frontmatter of the documents of all formats, which we use as the preferred title/description source.
+// Both the `<!-- frontmatter -->` comment and the YAML front matter delimited by `---` lines are supported, the latter is
+// also the front matter of AsciiDoc documents, which Asciidoctor skips with the `skip-front-matter` attribute.
 func (doc *Doc) parseFrontmatter(key docDataKey) string {
 	if doc.frontmatterReg == nil {
 		return ""
@@ -239,6 +343,12 @@ func (doc *Doc) parseFrontmatter(key docDataKey) string {
 	if doc.frontmatterCache == nil {
 		doc.frontmatterCache = make(map[docDataKey]string)
 
+		if match := yamlFrontmatterReg.FindStringSubmatch(doc.rawContent); len(match) > 0 {
+			doc.parseYAMLFrontmatter(match[1])
+
+			return doc.frontmatterCache[key]
+		}
+
 		match := doc.frontmatterReg.FindStringSubmatch(doc.rawContent)
 		if len(match) == 0 {
 			return ""
@@ -261,6 +371,33 @@ func (doc *Doc) parseFrontmatter(key docDataKey) string {
 	return doc.frontmatterCache[key]
 }
 
+// parseYAMLFrontmatter fills the frontmatter cache from the given YAML front matter. Malformed front matter is recorded
+// in `frontmatterErr` and ignored.
+func (doc *Doc) parseYAMLFrontmatter(content string) {
+	var fields map[string]any
+
+	if err := yaml.Unmarshal([]byte(content), &fields); err != nil {
+		doc.frontmatterErr = errors.Errorf("malformed front matter: %w", err)
+
+		return
+	}
+
+	vals := make(map[string]any, len(fields))
+
+	for name, val := range fields {
+		vals[strings.ToLower(name)] = val
+	}
+
+	// `title` takes precedence over `name`
+	for _, name := range []string{"title", "name", "description"} {
+		key := frontmatterKeys[name]
+
+		if str, ok := vals[name].(string); ok && doc.frontmatterCache[key] == "" {
+			doc.frontmatterCache[key] = strings.TrimSpace(str)
+		}
+	}
+}
+
 // parseTag parses Markdown/AsciiDoc files, stips tags and extracts the H1 header as the title and the H1+H2 bodies as the description.
 func (doc *Doc) parseTag(key docDataKey) string {
 	if doc.tagRegs == nil {
@@ -270,10 +407,13 @@ func (doc *Doc) parseTag(key docDataKey) string {
 	if doc.tagCache == nil {
 		doc.tagCache = make(map[docDataKey]string)
 
-		var h1Body, h2Body string
+		var (
+			h1Body, h2Body string
+			body           = doc.Body()
+		)
 
 		for tagName, tagReg := range doc.tagRegs {
-			match := tagReg.FindStringSubmatch(doc.rawContent)
+			match := tagReg.FindStringSubmatch(body)
 			if len(match) == 0 {
 				continue
 			}
@@ -307,7 +447,7 @@ func (doc *Doc) parseTag(key docDataKey) string {
 		doc.tagCache[docDescription] = desc
 
 		// strip doc tags
-		content := doc.tagStripRegs.Replace(doc.rawContent)
+		content := doc.tagStripRegs.Replace(body)
 
 		doc.tagCache[docContent] = content
 	}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gruntwork-io/terragrunt/cli/commands/catalog/module"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var testFrontmatterEcsCluster = `
@@ -78,6 +79,20 @@ func TestFrontmatter(t *testing.T) {
 
 }
 
+func TestFrontmatterAsciiDoc(t *testing.T) {
+	t.Parallel()
+
+	content := "---\ntitle: Amazon VPC\ndescription: Deploy a VPC with public and private subnets.\n---\n= VPC Module\n\nThis module creates a VPC.\n"
+
+	doc := module.NewDoc(content, ".adoc")
+
+	assert.Equal(t, "Amazon VPC", doc.Title())
+	assert.Equal(t, "Deploy a VPC with public and private subnets.", doc.Description(0))
+	assert.Equal(t, "= VPC Module\n\nThis module creates a VPC.\n", doc.Body())
+	assert.NotContains(t, doc.Content(true), "Amazon VPC")
+	require.NoError(t, doc.FrontmatterError())
+}
+
 var testH1EksK8sArgocd = `
 # EKS K8s GitOps Module
 This module deploys [Argo CD](https://argo-cd.readthedocs.io/en/stable/) to an EKS cluster. Argo CD is a declarative GitOps continuous delivery tool for Kubernetes. See the [Argo CD](https://argo-cd.readthedocs.io/en/stable/) for more details. This module supports deploying the Argo CD resources to Fargate in addition to EC2 Worker Nodes.
@@ -0,0 +1,169 @@
+package module
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrOfflineCacheMiss is returned by `NewRepo` in offline mode if there is no fresh clone of the repository on disk.
+var ErrOfflineCacheMiss = errors.New("offline mode: no fresh clone of the repository found in cache")
+
+// The clone failures are classified into the following categories, which can be checked with `errors.Is`.
+var (
+	// ErrNotGitRepo is returned by `NewRepo` if the local or cloned directory is not a git repository.
+	ErrNotGitRepo = errors.New("not a git repository")
+	// ErrCloneAuth is returned by `NewRepo` if the clone is rejected due to missing or invalid credentials.
+	ErrCloneAuth = errors.New("authentication failed")
+	// ErrCloneNetwork is returned by `NewRepo` if the clone fails due to a network issue, after all retries.
+	ErrCloneNetwork = errors.New("network failure")
+	// ErrCloneDiskFull is returned by `NewRepo` if there is not enough disk space for the clone.
+	ErrCloneDiskFull = errors.New("no space left on device")
+)
+
+// ErrCheckout is returned by `Repo.Checkout` if the ref cannot be fetched or checked out.
+var ErrCheckout = errors.New("checkout failed")
+
+// RefNotFoundError is returned by `Repo.CommitSHA` if the ref that `.git/HEAD` points to cannot be found
+// neither as a loose ref nor in `.git/packed-refs`.
+type RefNotFoundError struct {
+	Ref      string
+	RepoPath string
+}
+
+func (err RefNotFoundError) Error() string {
+	return fmt.Sprintf("ref %q not found in repository %q", err.Ref, err.RepoPath)
+}
+
+// redactedError hides the credentials of the clone URL in the message of the wrapped error.
+type redactedError struct {
+	err error
+	msg string
+}
+
+func (err *redactedError) Error() string {
+	return err.msg
+}
+
+func (err *redactedError) Unwrap() error {
+	return err.err
+}
+
+// UndefinedCloneURLVarsError is returned by `NewRepo` if the clone URL references env vars that are not defined.
+type UndefinedCloneURLVarsError struct {
+	CloneURL string
+	Vars     []string
+}
+
+func (err UndefinedCloneURLVarsError) Error() string {
+	return fmt.Sprintf("clone URL %q references undefined env vars: %s", err.CloneURL, strings.Join(err.Vars, ", "))
+}
+
+// ChecksumMismatchError is returned by `NewRepo` if the checksum of the downloaded archive differs from the one set by `WithChecksum`.
+type ChecksumMismatchError struct {
+	CloneURL string
+	Expected string
+	Actual   string
+}
+
+func (err ChecksumMismatchError) Error() string {
+	return fmt.Sprintf("checksum mismatch for %q: expected %s, got %s", err.CloneURL, err.Expected, err.Actual)
+}
+
+// CommitSHAMismatchError is returned by `NewRepo` if the cloned HEAD differs from the commit set by `WithExpectedCommitSHA`.
+type CommitSHAMismatchError struct {
+	CloneURL string
+	Expected string
+	Actual   string
+}
+
+func (err CommitSHAMismatchError) Error() string {
+	return fmt.Sprintf("commit SHA mismatch for %q: expected %s, got %s", err.CloneURL, err.Expected, err.Actual)
+}
+
+// DuplicateModuleError is returned by `Modules.Dedup` with the `DuplicateError` policy if two modules resolve to the same URL.
+type DuplicateModuleError struct {
+	URL          string
+	FirstSource  string
+	SecondSource string
+}
+
+func (err DuplicateModuleError) Error() string {
+	return fmt.Sprintf("modules %q and %q resolve to the same URL %q", err.FirstSource, err.SecondSource, err.URL)
+}
+
+// ModuleCycleError is returned by `Modules.BuildGraph` if the modules reference each other in a cycle.
+type ModuleCycleError struct {
+	Cycles [][]string
+}
+
+func (err ModuleCycleError) Error() string {
+	cycles := make([]string, len(err.Cycles))
+
+	for i, cycle := range err.Cycles {
+		cycles[i] = strings.Join(cycle, " -> ")
+	}
+
+	return "module dependency cycles found: " + strings.Join(cycles, "; ")
+}
+
+// PartialDiscoveryError is returned by `FindModules` when some of the modules could not be discovered or the layout file could not be read.
+// The successfully discovered modules are returned along with this error.
+type PartialDiscoveryError struct {
+	// Errors contains the discovery errors keyed by the module directory, or the layout file name, relative to the repository root.
+	Errors map[string]error
+}
+
+// Add records the error for the given `moduleDir`, only the first error is kept for each directory.
+func (err *PartialDiscoveryError) Add(moduleDir string, moduleErr error) {
+	if err.Errors == nil {
+		err.Errors = make(map[string]error)
+	}
+
+	if _, ok := err.Errors[moduleDir]; !ok {
+		err.Errors[moduleDir] = moduleErr
+	}
+}
+
+// ModuleDirs returns the sorted directories of the modules that failed to be discovered.
+func (err *PartialDiscoveryError) ModuleDirs() []string {
+	dirs := make([]string, 0, len(err.Errors))
+
+	for dir := range err.Errors {
+		dirs = append(dirs, dir)
+	}
+
+	sort.Strings(dirs)
+
+	return dirs
+}
+
+// ErrorOrNil returns nil if no errors have been recorded.
+func (err *PartialDiscoveryError) ErrorOrNil() error {
+	if err == nil || len(err.Errors) == 0 {
+		return nil
+	}
+
+	return err
+}
+
+func (err *PartialDiscoveryError) Error() string {
+	var msgs = make([]string, 0, len(err.Errors))
+
+	for _, dir := range err.ModuleDirs() {
+		msgs = append(msgs, fmt.Sprintf("%s: %v", dir, err.Errors[dir]))
+	}
+
+	return fmt.Sprintf("failed to discover %d module(s):\n\t%s", len(msgs), strings.Join(msgs, "\n\t"))
+}
+
+func (err *PartialDiscoveryError) Unwrap() []error {
+	errs := make([]error, 0, len(err.Errors))
+
+	for _, dir := range err.ModuleDirs() {
+		errs = append(errs, err.Errors[dir])
+	}
+
+	return errs
+}
@@ -0,0 +1,47 @@
+package module
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+)
+
+// runGit runs the git command with the given args in the repo dir and returns its stdout.
+func (repo *Repo) runGit(ctx context.Context, args ...string) (string, error) {
+	return repo.runGitIn(ctx, repo.path, args...)
+}
+
+// runGitIn runs the git command with the given args in the given `dir`, or in the current dir if `dir` is empty, and returns its stdout.
+// The HTTP(S) requests of the command are sent with the user agent set by `WithUserAgent`, through the proxy set by `WithProxy`.
+func (repo *Repo) runGitIn(ctx context.Context, dir string, args ...string) (string, error) {
+	var (
+		stderr  bytes.Buffer
+		gitArgs []string
+	)
+
+	if dir != "" {
+		gitArgs = append(gitArgs, "-C", dir)
+	}
+
+	if repo.userAgent != "" {
+		gitArgs = append(gitArgs, "-c", "http.userAgent="+repo.userAgent)
+	}
+
+	cmd := exec.CommandContext(ctx, "git", append(gitArgs, args...)...)
+	cmd.Stderr = &stderr
+
+	if repo.proxy != nil {
+		cmd.Env = append(os.Environ(), repo.proxy.env()...)
+	}
+
+	output, err := cmd.Output()
+	if err != nil {
+		return "", errors.Errorf("git %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	return string(output), nil
+}
@@ -0,0 +1,120 @@
+package module
+
+import (
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/hashicorp/terraform-config-inspect/tfconfig"
+)
+
+// ModuleGraph is the directed graph of the dependencies between the modules of a repository. It maps the directory of each module
+// to the sorted directories of the modules it calls via local `source` paths, e.g. `source = "../vpc"`.
+type ModuleGraph map[string][]string
+
+// BuildGraph parses the module calls of the modules and returns the graph of the dependencies between them. Only the calls with
+// local sources resolving to the other given modules of the same repository are taken into account. If the graph has cycles,
+// it is returned along with `ModuleCycleError`.
+func (modules Modules) BuildGraph() (ModuleGraph, error) {
+	graph := make(ModuleGraph, len(modules))
+	moduleDirs := make(map[string]bool, len(modules))
+
+	for _, module := range modules {
+		moduleDirs[moduleKey(module)] = true
+	}
+
+	for _, module := range modules {
+		var (
+			moduleDir = path.Clean(filepath.ToSlash(module.moduleDir))
+			deps      = []string{}
+		)
+
+		config, diags := tfconfig.LoadModule(filepath.Join(module.repoPath, module.moduleDir))
+		if diags.HasErrors() {
+			module.Logger().Debugf("Module %q has errors, its dependencies may be incomplete: %v", module.moduleDir, diags.Err())
+		}
+
+		for _, call := range config.ModuleCalls {
+			if !isLocalModuleSource(call.Source) {
+				continue
+			}
+
+			depDir := path.Join(moduleDir, call.Source)
+
+			if depDir == moduleDir || !moduleDirs[module.repoPath+"//"+depDir] || slices.Contains(deps, depDir) {
+				continue
+			}
+
+			deps = append(deps, depDir)
+		}
+
+		slices.Sort(deps)
+		graph[moduleDir] = deps
+	}
+
+	if cycles := graph.Cycles(); len(cycles) > 0 {
+		return graph, errors.New(ModuleCycleError{Cycles: cycles})
+	}
+
+	return graph, nil
+}
+
+// Cycles returns the dependency cycles of the graph, each as the list of module directories starting and ending with the same module.
+func (graph ModuleGraph) Cycles() [][]string {
+	const (
+		visiting = iota + 1
+		visited
+	)
+
+	var (
+		cycles [][]string
+		states = make(map[string]int, len(graph))
+		stack  []string
+		visit  func(dir string)
+	)
+
+	visit = func(dir string) {
+		states[dir] = visiting
+		stack = append(stack, dir)
+
+		for _, dep := range graph[dir] {
+			switch states[dep] {
+			case visiting:
+				start := slices.Index(stack, dep)
+				cycles = append(cycles, append(slices.Clone(stack[start:]), dep))
+			case 0:
+				visit(dep)
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		states[dir] = visited
+	}
+
+	dirs := make([]string, 0, len(graph))
+	for dir := range graph {
+		dirs = append(dirs, dir)
+	}
+
+	slices.Sort(dirs)
+
+	for _, dir := range dirs {
+		if states[dir] == 0 {
+			visit(dir)
+		}
+	}
+
+	return cycles
+}
+
+// moduleKey returns the key identifying the module across the repositories.
+func moduleKey(module *Module) string {
+	return module.repoPath + "//" + path.Clean(filepath.ToSlash(module.moduleDir))
+}
+
+// isLocalModuleSource returns true if the given module `source` is a local path, which must start with `./` or `../`.
+func isLocalModuleSource(source string) bool {
+	return strings.HasPrefix(source, "./") || strings.HasPrefix(source, "../")
+}
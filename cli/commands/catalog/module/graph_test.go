@@ -0,0 +1,123 @@
+package module_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/cli/commands/catalog/module"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModulesBuildGraph(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		modules        map[string]string
+		expectedGraph  module.ModuleGraph
+		expectedCycles [][]string
+	}{
+		{
+			name: "chain",
+			modules: map[string]string{
+				"modules/app": `
+module "eks" {
+  source = "../eks"
+}
+
+module "remote" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "5.0.0"
+}
+`,
+				"modules/eks": `
+module "vpc" {
+  source = "../vpc/"
+}
+
+module "missing" {
+  source = "../missing"
+}
+`,
+				"modules/vpc": `variable "cidr" {}`,
+			},
+			expectedGraph: module.ModuleGraph{
+				"modules/app": {"modules/eks"},
+				"modules/eks": {"modules/vpc"},
+				"modules/vpc": {},
+			},
+		},
+		{
+			name: "cycle",
+			modules: map[string]string{
+				"modules/a": `
+module "b" {
+  source = "../b"
+}
+`,
+				"modules/b": `
+module "c" {
+  source = "./../c"
+}
+`,
+				"modules/c": `
+module "a" {
+  source = "../a"
+}
+`,
+				"modules/d": `
+module "a" {
+  source = "../a"
+}
+`,
+			},
+			expectedGraph: module.ModuleGraph{
+				"modules/a": {"modules/b"},
+				"modules/b": {"modules/c"},
+				"modules/c": {"modules/a"},
+				"modules/d": {"modules/a"},
+			},
+			expectedCycles: [][]string{
+				{"modules/a", "modules/b", "modules/c", "modules/a"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repoPath := t.TempDir()
+
+			for moduleDir, content := range tc.modules {
+				modulePath := filepath.Join(repoPath, moduleDir)
+
+				require.NoError(t, os.MkdirAll(modulePath, os.ModePerm))
+				require.NoError(t, os.WriteFile(filepath.Join(modulePath, "main.tf"), []byte(content), 0644))
+			}
+
+			modules, err := newLocalRepo(t, repoPath).FindModules(context.Background())
+			require.NoError(t, err)
+			require.Len(t, modules, len(tc.modules))
+
+			graph, err := modules.BuildGraph()
+			assert.Equal(t, tc.expectedGraph, graph)
+			assert.Equal(t, tc.expectedCycles, graph.Cycles())
+
+			if tc.expectedCycles == nil {
+				require.NoError(t, err)
+
+				return
+			}
+
+			var cycleErr module.ModuleCycleError
+
+			require.True(t, errors.As(err, &cycleErr))
+			assert.Equal(t, tc.expectedCycles, cycleErr.Cycles)
+		})
+	}
+}
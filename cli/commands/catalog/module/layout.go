@@ -0,0 +1,106 @@
+package module
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// layoutFileName is the name of the optional file in the repository root that declares how the modules are grouped and ordered, e.g.
+	//
+	//	groups:
+	//	  - name: networking
+	//	    modules:
+	//	      - modules/vpc
+	//	      - modules/nat
+	layoutFileName = ".terragrunt-catalog.yml"
+
+	// UngroupedName is the group of the modules that are not listed in the layout file.
+	UngroupedName = "ungrouped"
+)
+
+// Layout declares the groups of the modules and their explicit order.
+type Layout struct {
+	Groups []LayoutGroup `yaml:"groups"`
+}
+
+// LayoutGroup is a named group of module paths, listed in the order they should be displayed.
+type LayoutGroup struct {
+	Name    string   `yaml:"name"`
+	Modules []string `yaml:"modules"`
+}
+
+// ReadLayout reads the layout file in the given repository `dir`. If there is no layout file, nil is returned.
+func ReadLayout(dir string) (*Layout, error) {
+	filePath := filepath.Join(dir, layoutFileName)
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, errors.New(err)
+	}
+
+	layout := new(Layout)
+
+	// The yaml errors include the line numbers, e.g. "yaml: line 3: mapping values are not allowed in this context".
+	if err := yaml.Unmarshal(content, layout); err != nil {
+		return nil, errors.Errorf("failed to parse %q: %w", filePath, err)
+	}
+
+	return layout, nil
+}
+
+// apply annotates the given `modules` with the group and the sort weight declared in the layout and returns them sorted.
+// The modules that are not listed fall to the `UngroupedName` group and are placed after the listed ones, sorted by path.
+func (layout *Layout) apply(modules Modules) Modules {
+	type position struct {
+		group string
+		index int
+	}
+
+	positions := make(map[string]position)
+
+	for _, group := range layout.Groups {
+		for _, moduleDir := range group.Modules {
+			moduleDir = filepath.Clean(filepath.FromSlash(moduleDir))
+
+			if _, ok := positions[moduleDir]; !ok {
+				positions[moduleDir] = position{group: group.Name, index: len(positions)}
+			}
+		}
+	}
+
+	sorted := make(Modules, len(modules))
+	copy(sorted, modules)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		posI, listedI := positions[filepath.Clean(sorted[i].moduleDir)]
+		posJ, listedJ := positions[filepath.Clean(sorted[j].moduleDir)]
+
+		switch {
+		case listedI && listedJ:
+			return posI.index < posJ.index
+		case listedI != listedJ:
+			return listedI
+		default:
+			return sorted[i].moduleDir < sorted[j].moduleDir
+		}
+	})
+
+	for i, module := range sorted {
+		module.group, module.sortWeight = UngroupedName, i
+
+		if pos, ok := positions[filepath.Clean(module.moduleDir)]; ok {
+			module.group = pos.group
+		}
+	}
+
+	return sorted
+}
@@ -0,0 +1,91 @@
+package module
+
+import (
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+)
+
+// CloneSource describes how the repository content was obtained.
+type CloneSource string
+
+const (
+	// CloneSourceLocal means the repository is a local directory that was used in place.
+	CloneSourceLocal CloneSource = "local"
+	// CloneSourceGetter means the repository was downloaded with `go-getter`.
+	CloneSourceGetter CloneSource = "getter"
+	// CloneSourceBundle means the repository was cloned from a git bundle file with the git CLI.
+	CloneSourceBundle CloneSource = "bundle"
+	// CloneSourceOfflineCache means a previously completed clone was reused in offline mode.
+	CloneSourceOfflineCache CloneSource = "offline-cache"
+)
+
+// Manifest describes what was cloned and discovered by the catalog, allowing downstream tooling to reproduce it.
+type Manifest struct {
+	CloneURL    string           `json:"clone_url"`
+	RemoteURL   string           `json:"remote_url,omitempty"`
+	CommitSHA   string           `json:"commit_sha,omitempty"`
+	BranchName  string           `json:"branch_name,omitempty"`
+	CloneSource CloneSource      `json:"clone_source"`
+	Modules     []ManifestModule `json:"modules"`
+}
+
+// ManifestModule describes a discovered module, paths are relative to the repository root.
+type ManifestModule struct {
+	Dir        string `json:"dir"`
+	ReadmePath string `json:"readme_path,omitempty"`
+}
+
+// Manifest returns the manifest of the repository, the modules are populated by the last `FindModules` call.
+// The modules are sorted by their dirs, regardless of the layout order, so that manifests of the same commit can be diffed.
+func (repo *Repo) Manifest() *Manifest {
+	commitSHA, err := repo.CommitSHA()
+	if err != nil {
+		repo.logger.Debugf("Could not resolve commit SHA for repo %q: %v", repo.path, err)
+	}
+
+	manifest := &Manifest{
+		CloneURL:    repo.redact(repo.cloneURL),
+		RemoteURL:   repo.RemoteURL,
+		CommitSHA:   commitSHA,
+		BranchName:  repo.BranchName,
+		CloneSource: repo.cloneSource,
+		Modules:     make([]ManifestModule, 0, len(repo.foundModules)),
+	}
+
+	for _, module := range repo.foundModules {
+		manifestModule := ManifestModule{Dir: module.ModuleDir()}
+
+		if readmePath := module.ReadmePath(); readmePath != "" {
+			if relPath, err := filepath.Rel(repo.path, readmePath); err == nil {
+				readmePath = relPath
+			}
+
+			manifestModule.ReadmePath = filepath.ToSlash(readmePath)
+		}
+
+		manifest.Modules = append(manifest.Modules, manifestModule)
+	}
+
+	slices.SortFunc(manifest.Modules, func(a, b ManifestModule) int {
+		return strings.Compare(a.Dir, b.Dir)
+	})
+
+	return manifest
+}
+
+// WriteManifest writes the JSON manifest of the repository to the given writer `w`.
+func (repo *Repo) WriteManifest(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(repo.Manifest()); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
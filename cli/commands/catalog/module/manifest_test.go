@@ -0,0 +1,139 @@
+package module_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/cli/commands/catalog/module"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoWriteManifest(t *testing.T) {
+	t.Parallel()
+
+	const commitSHA = "2b1f0c7e9d3a4b5c6d7e8f90a1b2c3d4e5f60718"
+
+	testCases := []struct {
+		name      string
+		writeRefs func(gitDir string) error
+	}{
+		{
+			name: "loose ref",
+			writeRefs: func(gitDir string) error {
+				if err := os.MkdirAll(filepath.Join(gitDir, "refs", "heads"), os.ModePerm); err != nil {
+					return err
+				}
+
+				return os.WriteFile(filepath.Join(gitDir, "refs", "heads", "main"), []byte(commitSHA+"\n"), 0644)
+			},
+		},
+		{
+			name: "packed ref",
+			writeRefs: func(gitDir string) error {
+				packedRefs := "# pack-refs with: peeled fully-peeled sorted\n" +
+					"0000000000000000000000000000000000000000 refs/heads/develop\n" +
+					commitSHA + " refs/heads/main\n"
+
+				return os.WriteFile(filepath.Join(gitDir, "packed-refs"), []byte(packedRefs), 0644)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repoPath := t.TempDir()
+
+			for _, moduleDir := range []string{"modules/vpc", "modules/nat"} {
+				require.NoError(t, os.MkdirAll(filepath.Join(repoPath, moduleDir), os.ModePerm))
+				require.NoError(t, os.WriteFile(filepath.Join(repoPath, moduleDir, "main.tf"), []byte{}, 0644))
+			}
+
+			require.NoError(t, os.WriteFile(filepath.Join(repoPath, "modules/vpc", "README.md"), []byte("# VPC\n"), 0644))
+
+			require.NoError(t, writeGitDir(t, repoPath, "https://github.com/acme/terraform-aws-modules.git"))
+			require.NoError(t, tc.writeRefs(filepath.Join(repoPath, ".git")))
+
+			repo := newLocalRepo(t, repoPath)
+
+			_, err := repo.FindModules(context.Background())
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+
+			require.NoError(t, repo.WriteManifest(&buf))
+
+			var actual module.Manifest
+
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &actual))
+
+			expected := module.Manifest{
+				CloneURL:    repoPath,
+				RemoteURL:   "https://github.com/acme/terraform-aws-modules.git",
+				CommitSHA:   commitSHA,
+				BranchName:  "main",
+				CloneSource: module.CloneSourceLocal,
+				Modules: []module.ManifestModule{
+					{Dir: "modules/nat"},
+					{Dir: "modules/vpc", ReadmePath: "modules/vpc/README.md"},
+				},
+			}
+
+			assert.Equal(t, expected, actual)
+		})
+	}
+}
+
+func TestRepoWriteManifestStable(t *testing.T) {
+	t.Parallel()
+
+	const cloneURL = "https://github.com/acme/terraform-aws-modules.git"
+
+	fakeGetter := func(_ context.Context, dst, _ string) error {
+		for _, moduleDir := range []string{"modules/alb", "modules/nat", "modules/vpc"} {
+			if err := os.MkdirAll(filepath.Join(dst, moduleDir), os.ModePerm); err != nil {
+				return err
+			}
+
+			if err := os.WriteFile(filepath.Join(dst, moduleDir, "main.tf"), []byte{}, 0644); err != nil {
+				return err
+			}
+		}
+
+		layout := "groups:\n  - name: networking\n    modules:\n      - modules/vpc\n      - modules/nat\n"
+		if err := os.WriteFile(filepath.Join(dst, ".terragrunt-catalog.yml"), []byte(layout), 0644); err != nil {
+			return err
+		}
+
+		return writeGitDir(t, dst, cloneURL)
+	}
+
+	writeManifest := func() []byte {
+		repo, err := module.NewRepo(context.Background(), log.New(), cloneURL, t.TempDir(), false, module.WithGetter(fakeGetter))
+		require.NoError(t, err)
+
+		_, err = repo.FindModules(context.Background())
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+
+		require.NoError(t, repo.WriteManifest(&buf))
+
+		return buf.Bytes()
+	}
+
+	first, second := writeManifest(), writeManifest()
+	assert.Equal(t, string(first), string(second))
+
+	var manifest module.Manifest
+
+	require.NoError(t, json.Unmarshal(first, &manifest))
+	assert.Equal(t, []module.ManifestModule{{Dir: "modules/alb"}, {Dir: "modules/nat"}, {Dir: "modules/vpc"}}, manifest.Modules)
+}
@@ -0,0 +1,51 @@
+package module
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsimple"
+)
+
+// metadataFileName is the name of the optional file in the module directory that contains the catalog metadata of the module, e.g.
+//
+//	tags = ["network", "aws"]
+const metadataFileName = "catalog-module.hcl"
+
+// Metadata is the catalog metadata of the module.
+type Metadata struct {
+	Tags []string `hcl:"tags,optional"`
+
+	Remain hcl.Body `hcl:",remain"`
+}
+
+// ReadMetadata reads the module metadata file in the given `dir`. If there is no metadata file, empty metadata is returned.
+func ReadMetadata(dir string) (*Metadata, error) {
+	return readMetadata(os.DirFS(dir), dir, ".")
+}
+
+// readMetadata reads the module metadata file in the given `dir` of `fsys`, which is rooted at the `root` dir.
+func readMetadata(fsys fs.FS, root, dir string) (*Metadata, error) {
+	metadata := new(Metadata)
+
+	filePath := filepath.Join(root, filepath.FromSlash(dir), metadataFileName)
+
+	content, err := fs.ReadFile(fsys, path.Join(dir, metadataFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return metadata, nil
+		}
+
+		return nil, errors.New(err)
+	}
+
+	if err := hclsimple.Decode(filePath, content, nil, metadata); err != nil {
+		return nil, errors.New(err)
+	}
+
+	return metadata, nil
+}
@@ -2,8 +2,11 @@
 package module
 
 import (
+	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 
 	"github.com/gruntwork-io/go-commons/collections"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
@@ -16,10 +19,25 @@ const (
 )
 
 var (
-	terraformFileExts = []string{".tf"}
+	terraformFileExts = []string{".tf", ".tf.json"}
+	terragruntFiles   = []string{"terragrunt.hcl", "terragrunt.hcl.json"}
 	ignoreFiles       = []string{"terraform-cloud-enterprise-private-module-registry-placeholder.tf"}
 )
 
+// DirKind is the classification of a directory by its files.
+type DirKind byte
+
+const (
+	// DirKindEmpty is a directory without OpenTofu/Terraform, Terragrunt or README files.
+	DirKindEmpty DirKind = iota
+	// DirKindDocsOnly is a directory with a README, but without OpenTofu/Terraform or Terragrunt files.
+	DirKindDocsOnly
+	// DirKindTerragrunt is a directory with a Terragrunt configuration, e.g. `terragrunt.hcl`, but without OpenTofu/Terraform files.
+	DirKindTerragrunt
+	// DirKindTerraform is a directory with OpenTofu/Terraform files, `*.tf` or `*.tf.json`.
+	DirKindTerraform
+)
+
 type Modules []*Module
 
 type Module struct {
@@ -30,10 +48,15 @@ type Module struct {
 	repoPath  string
 	moduleDir string
 	url       string
+	tags      []string
+
+	group      string
+	sortWeight int
 }
 
-// NewModule returns a module instance if the given `moduleDir` path contains a Terraform module, otherwise returns nil.
-func NewModule(repo *Repo, moduleDir string) (*Module, error) {
+// NewModule returns a module instance if the given `moduleDir` path contains OpenTofu/Terraform or Terragrunt files, otherwise returns nil.
+// The directories with only a README are returned too, if `WithDocsOnlyDirs` is given.
+func NewModule(repo *Repo, moduleDir string, opts ...FindOption) (*Module, error) {
 	module := &Module{
 		Repo:      repo,
 		cloneURL:  repo.cloneURL,
@@ -41,7 +64,7 @@ func NewModule(repo *Repo, moduleDir string) (*Module, error) {
 		moduleDir: moduleDir,
 	}
 
-	if ok, err := module.isValid(); !ok || err != nil {
+	if ok, err := module.isValid(newFindOptions(opts)); !ok || err != nil {
 		return nil, err
 	}
 
@@ -54,15 +77,51 @@ func NewModule(repo *Repo, moduleDir string) (*Module, error) {
 
 	module.url = moduleURL
 
-	modulePath := filepath.Join(module.repoPath, module.moduleDir)
+	fsys := repo.fileSystem()
 
-	doc, err := FindDoc(modulePath)
+	doc, err := findDoc(fsys, repo.path, fsPath(moduleDir))
 	if err != nil {
 		return nil, err
 	}
 
 	module.Doc = doc
 
+	if err := doc.FrontmatterError(); err != nil {
+		repo.logger.Debugf("Ignoring the front matter of %q: %v", doc.FilePath(), err)
+	}
+
+	metadata, err := readMetadata(fsys, repo.path, fsPath(moduleDir))
+	if err != nil {
+		return nil, err
+	}
+
+	module.tags = metadata.Tags
+
+	return module, nil
+}
+
+// newModuleStub returns a module instance without the README and metadata indexed, if the given `moduleDir` path contains a Terraform module,
+// otherwise returns nil. Only the module path and URL are set.
+func newModuleStub(repo *Repo, moduleDir string, opts ...FindOption) (*Module, error) {
+	module := &Module{
+		Repo:      repo,
+		Doc:       &Doc{format: ReadmeFormatNone},
+		cloneURL:  repo.cloneURL,
+		repoPath:  repo.path,
+		moduleDir: moduleDir,
+	}
+
+	if ok, err := module.isValid(newFindOptions(opts)); !ok || err != nil {
+		return nil, err
+	}
+
+	moduleURL, err := repo.ModuleURL(moduleDir)
+	if err != nil {
+		return nil, err
+	}
+
+	module.url = moduleURL
+
 	return module, nil
 }
 
@@ -93,6 +152,37 @@ func (module *Module) Description() string {
 	return defaultDescription
 }
 
+// ReadmePath returns the path of the module README file, or an empty string if the module has no README.
+func (module *Module) ReadmePath() string {
+	return module.Doc.FilePath()
+}
+
+// ReadmeFormat returns the detected format of the module README file, `ReadmeFormatNone` if the module has no README.
+func (module *Module) ReadmeFormat() ReadmeFormat {
+	return module.Doc.Format()
+}
+
+// Readme returns the raw content of the module README file.
+func (module *Module) Readme() []byte {
+	return module.Doc.Bytes()
+}
+
+// Tags returns the tags declared in the module metadata file, or nil if the module is untagged.
+func (module *Module) Tags() []string {
+	return module.tags
+}
+
+// Group returns the group declared for the module in the repository layout file, `UngroupedName` if the module is not listed,
+// or an empty string if the repository has no layout file.
+func (module *Module) Group() string {
+	return module.group
+}
+
+// SortWeight returns the position of the module in the order declared in the repository layout file.
+func (module *Module) SortWeight() int {
+	return module.sortWeight
+}
+
 func (module *Module) URL() string {
 	return module.url
 }
@@ -101,28 +191,65 @@ func (module *Module) TerraformSourcePath() string {
 	return module.cloneURL + "//" + module.moduleDir
 }
 
-func (module *Module) isValid() (bool, error) {
-	files, err := os.ReadDir(filepath.Join(module.repoPath, module.moduleDir))
+func (module *Module) isValid(opts *findOptions) (bool, error) {
+	kind, err := classifyDir(module.fileSystem(), fsPath(module.moduleDir))
+	if err != nil {
+		return false, err
+	}
+
+	return kind >= DirKindTerragrunt || (opts.docsOnlyDirs && kind == DirKindDocsOnly), nil
+}
+
+// isModuleDir returns true if the given `dir` of `fsys` contains OpenTofu/Terraform or Terragrunt files.
+func isModuleDir(fsys fs.FS, dir string) (bool, error) {
+	kind, err := classifyDir(fsys, dir)
+
+	return kind >= DirKindTerragrunt, err
+}
+
+// ClassifyDir classifies the given `dir` by its files, the subdirectories are not inspected.
+func ClassifyDir(dir string) (DirKind, error) {
+	return classifyDir(os.DirFS(dir), ".")
+}
+
+// classifyDir classifies the given `dir` of `fsys` by its files.
+func classifyDir(fsys fs.FS, dir string) (DirKind, error) {
+	files, err := fs.ReadDir(fsys, dir)
 	if err != nil {
-		return false, errors.New(err)
+		return DirKindEmpty, errors.New(err)
 	}
 
+	kind := DirKindEmpty
+
 	for _, file := range files {
 		if file.IsDir() {
 			continue
 		}
 
-		if collections.ListContainsElement(ignoreFiles, file.Name()) {
+		name := file.Name()
+
+		switch {
+		case collections.ListContainsElement(ignoreFiles, name):
 			continue
+		case slices.ContainsFunc(terraformFileExts, func(ext string) bool { return strings.HasSuffix(name, ext) }):
+			return DirKindTerraform, nil
+		case collections.ListContainsElement(terragruntFiles, name):
+			kind = DirKindTerragrunt
+		case kind == DirKindEmpty && slices.ContainsFunc(docFiles, func(docFile string) bool { return strings.EqualFold(docFile, name) }):
+			kind = DirKindDocsOnly
 		}
+	}
 
-		ext := filepath.Ext(file.Name())
-		if collections.ListContainsElement(terraformFileExts, ext) {
-			return true, nil
-		}
+	return kind, nil
+}
+
+// fsPath converts the module dir, relative to the repository root, to the path in the repository file system.
+func fsPath(moduleDir string) string {
+	if moduleDir == "" {
+		return "."
 	}
 
-	return false, nil
+	return filepath.ToSlash(moduleDir)
 }
 
 func (module *Module) ModuleDir() string {
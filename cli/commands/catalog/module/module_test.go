@@ -0,0 +1,268 @@
+package module_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/cli/commands/catalog/module"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModuleReadmeFormat(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		readmeFile     string
+		readmeContent  string
+		expectedFormat module.ReadmeFormat
+		expectedTitle  string
+	}{
+		{
+			"markdown",
+			"README.md",
+			"# VPC Module\n\nThis module creates a VPC.\n",
+			module.ReadmeFormatMarkdown,
+			"VPC Module",
+		},
+		{
+			"asciidoc",
+			"README.adoc",
+			"= VPC Module\n\nThis module creates a VPC.\n",
+			module.ReadmeFormatAsciiDoc,
+			"VPC Module",
+		},
+		{
+			"sniffed asciidoc",
+			"README",
+			"= VPC Module\n\nThis module creates a VPC.\n",
+			module.ReadmeFormatAsciiDoc,
+			"VPC Module",
+		},
+		{
+			"plain text",
+			"README.txt",
+			"This module creates a VPC.\n",
+			module.ReadmeFormatPlainText,
+			"vpc",
+		},
+		{
+			"missing",
+			"",
+			"",
+			module.ReadmeFormatNone,
+			"vpc",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			repoPath := t.TempDir()
+			moduleDir := filepath.Join("modules", "vpc")
+			modulePath := filepath.Join(repoPath, moduleDir)
+
+			require.NoError(t, os.MkdirAll(modulePath, os.ModePerm))
+			require.NoError(t, os.WriteFile(filepath.Join(modulePath, "main.tf"), []byte{}, 0644))
+
+			var expectedReadmePath string
+
+			if testCase.readmeFile != "" {
+				expectedReadmePath = filepath.Join(modulePath, testCase.readmeFile)
+				require.NoError(t, os.WriteFile(expectedReadmePath, []byte(testCase.readmeContent), 0644))
+			}
+
+			mod, err := module.NewModule(newLocalRepo(t, repoPath), moduleDir)
+			require.NoError(t, err)
+			require.NotNil(t, mod)
+
+			assert.Equal(t, testCase.expectedFormat, mod.ReadmeFormat())
+			assert.Equal(t, expectedReadmePath, mod.ReadmePath())
+			assert.Equal(t, testCase.readmeContent, string(mod.Readme()))
+			assert.Equal(t, testCase.expectedTitle, mod.Title())
+		})
+	}
+}
+
+// newLocalRepo returns a repo instance for the given local `repoPath` with a minimal `.git` directory.
+func newLocalRepo(t testing.TB, repoPath string) *module.Repo {
+	t.Helper()
+
+	require.NoError(t, writeGitDir(t, repoPath, "https://github.com/acme/terraform-aws-modules.git"))
+
+	repo, err := module.NewRepo(context.Background(), log.New(), repoPath, "", false)
+	require.NoError(t, err)
+
+	return repo
+}
+
+func TestModuleReadmeFrontmatter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                string
+		readmeContent       string
+		expectedTitle       string
+		expectedDescription string
+		expectedContent     string
+	}{
+		{
+			"front matter",
+			"---\ntitle: Amazon VPC\ndescription: Deploy a VPC with public and private subnets.\ntags: [network]\n---\n# VPC Module\n\nThis module creates a VPC.\n",
+			"Amazon VPC",
+			"Deploy a VPC with public and private subnets.",
+			"# VPC Module\n\nThis module creates a VPC.\n",
+		},
+		{
+			"front matter name",
+			"---\nname: Amazon VPC\n---\n# VPC Module\n\nThis module creates a VPC.\n",
+			"Amazon VPC",
+			"This module creates a VPC.",
+			"# VPC Module\n\nThis module creates a VPC.\n",
+		},
+		{
+			"h1 fallback",
+			"# VPC Module\n\nThis module creates a VPC.\n",
+			"VPC Module",
+			"This module creates a VPC.",
+			"# VPC Module\n\nThis module creates a VPC.\n",
+		},
+		{
+			"malformed front matter",
+			"---\ntitle: [Amazon VPC\n---\n# VPC Module\n\nThis module creates a VPC.\n",
+			"VPC Module",
+			"This module creates a VPC.",
+			"# VPC Module\n\nThis module creates a VPC.\n",
+		},
+		{
+			"no readme",
+			"",
+			"vpc",
+			"(no description found)",
+			"",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			repoPath := t.TempDir()
+			moduleDir := filepath.Join("modules", "vpc")
+			modulePath := filepath.Join(repoPath, moduleDir)
+
+			require.NoError(t, os.MkdirAll(modulePath, os.ModePerm))
+			require.NoError(t, os.WriteFile(filepath.Join(modulePath, "main.tf"), []byte{}, 0644))
+
+			if testCase.readmeContent != "" {
+				require.NoError(t, os.WriteFile(filepath.Join(modulePath, "README.md"), []byte(testCase.readmeContent), 0644))
+			}
+
+			mod, err := module.NewModule(newLocalRepo(t, repoPath), moduleDir)
+			require.NoError(t, err)
+			require.NotNil(t, mod)
+
+			assert.Equal(t, testCase.expectedTitle, mod.Title())
+			assert.Equal(t, testCase.expectedDescription, mod.Description())
+			assert.Equal(t, testCase.expectedContent, mod.Body())
+			assert.Equal(t, testCase.readmeContent, mod.Content(false))
+			assert.Equal(t, testCase.readmeContent, string(mod.Readme()))
+		})
+	}
+}
+
+func TestNewModuleDirKind(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		files            []string
+		docsOnlyDirs     bool
+		expectedKind     module.DirKind
+		expectedIncluded bool
+	}{
+		{
+			name:             "terraform module",
+			files:            []string{"main.tf", "README.md"},
+			expectedKind:     module.DirKindTerraform,
+			expectedIncluded: true,
+		},
+		{
+			name:             "terraform json module",
+			files:            []string{"main.tf.json"},
+			expectedKind:     module.DirKindTerraform,
+			expectedIncluded: true,
+		},
+		{
+			name:             "terragrunt unit",
+			files:            []string{"terragrunt.hcl"},
+			expectedKind:     module.DirKindTerragrunt,
+			expectedIncluded: true,
+		},
+		{
+			name:         "empty dir",
+			expectedKind: module.DirKindEmpty,
+		},
+		{
+			name:         "unrelated files",
+			files:        []string{"variables.json", "notes.md"},
+			expectedKind: module.DirKindEmpty,
+		},
+		{
+			name:         "docs only",
+			files:        []string{"README.md"},
+			expectedKind: module.DirKindDocsOnly,
+		},
+		{
+			name:             "docs only requested",
+			files:            []string{"README.md"},
+			docsOnlyDirs:     true,
+			expectedKind:     module.DirKindDocsOnly,
+			expectedIncluded: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repoPath := t.TempDir()
+			moduleDir := filepath.Join("modules", "vpc")
+			modulePath := filepath.Join(repoPath, moduleDir)
+
+			require.NoError(t, os.MkdirAll(modulePath, os.ModePerm))
+
+			for _, file := range tc.files {
+				require.NoError(t, os.WriteFile(filepath.Join(modulePath, file), []byte{}, 0644))
+			}
+
+			kind, err := module.ClassifyDir(modulePath)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedKind, kind)
+
+			var opts []module.FindOption
+			if tc.docsOnlyDirs {
+				opts = append(opts, module.WithDocsOnlyDirs())
+			}
+
+			mod, err := module.NewModule(newLocalRepo(t, repoPath), moduleDir, opts...)
+			require.NoError(t, err)
+
+			if !tc.expectedIncluded {
+				assert.Nil(t, mod)
+
+				return
+			}
+
+			require.NotNil(t, mod)
+			assert.Equal(t, moduleDir, mod.ModuleDir())
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package module
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+)
+
+// TagMatchMode defines how the modules are matched against multiple tags.
+type TagMatchMode byte
+
+const (
+	// TagMatchAll matches the modules that have all the given tags.
+	TagMatchAll TagMatchMode = iota
+	// TagMatchAny matches the modules that have at least one of the given tags.
+	TagMatchAny
+)
+
+// DuplicatePolicy defines how the modules resolving to the same URL are handled.
+type DuplicatePolicy string
+
+const (
+	// DuplicateFirstWins keeps the first module and drops the following ones with the same URL.
+	DuplicateFirstWins DuplicatePolicy = "first-wins"
+	// DuplicateKeepBoth keeps all modules, even if they resolve to the same URL.
+	DuplicateKeepBoth DuplicatePolicy = "keep-both"
+	// DuplicateError returns `*DuplicateModuleError` if two modules resolve to the same URL.
+	DuplicateError DuplicatePolicy = "error"
+)
+
+// DuplicatePolicies contains the duplicate policies that can be specified by the user.
+var DuplicatePolicies = []DuplicatePolicy{DuplicateFirstWins, DuplicateKeepBoth, DuplicateError} //nolint:gochecknoglobals
+
+const (
+	dumpReadmeBaseName = "README"
+	dumpReadmeFileMode = 0644
+)
+
+// WriteReadmes writes the indexed README of each module to `<dir>/<module-dir>/README.md`, creating nested directories as needed.
+// The READMEs in other formats keep their extension, e.g. `README.adoc`, so that they are not rendered as Markdown.
+// Modules without a README are skipped.
+func (modules Modules) WriteReadmes(dir string) error {
+	for _, module := range modules {
+		if module.ReadmeFormat() == ReadmeFormatNone {
+			module.Logger().Debugf("Module %q has no README, skipping", module.ModuleDir())
+			continue
+		}
+
+		readmePath := filepath.Join(dir, module.ModuleDir(), dumpReadmeFileName(module.ReadmePath(), module.ReadmeFormat()))
+
+		if err := os.MkdirAll(filepath.Dir(readmePath), os.ModePerm); err != nil {
+			return errors.New(err)
+		}
+
+		if err := os.WriteFile(readmePath, module.Readme(), dumpReadmeFileMode); err != nil {
+			return errors.New(err)
+		}
+
+		module.Logger().Debugf("Wrote README of module %q to %q", module.ModuleDir(), readmePath)
+	}
+
+	return nil
+}
+
+// dumpReadmeFileName returns the name of the README file written by `WriteReadmes`, with the extension of the given README `format`,
+// or the extension of the source file `readmePath` if the format has none, e.g. for plain text.
+func dumpReadmeFileName(readmePath string, format ReadmeFormat) string {
+	ext := format.fileExt()
+	if ext == "" {
+		ext = strings.ToLower(filepath.Ext(readmePath))
+	}
+
+	return dumpReadmeBaseName + ext
+}
+
+// FilterByTag returns the modules matching the given `tags` according to the `mode`. Untagged modules never match,
+// so they are excluded by any non-empty filter. If no tags are given, all modules are returned.
+func (modules Modules) FilterByTag(mode TagMatchMode, tags ...string) Modules {
+	if len(tags) == 0 {
+		return modules
+	}
+
+	var filtered Modules
+
+	for _, module := range modules {
+		if module.hasTags(mode, tags) {
+			filtered = append(filtered, module)
+		}
+	}
+
+	return filtered
+}
+
+func (module *Module) hasTags(mode TagMatchMode, tags []string) bool {
+	for _, tag := range tags {
+		found := slices.Contains(module.tags, tag)
+
+		if mode == TagMatchAny && found {
+			return true
+		}
+
+		if mode == TagMatchAll && !found {
+			return false
+		}
+	}
+
+	return mode == TagMatchAll
+}
+
+// Dedup handles the modules resolving to the same `URL()` according to the given `policy`, the order of the modules is preserved.
+func (modules Modules) Dedup(policy DuplicatePolicy) (Modules, error) {
+	if policy == DuplicateKeepBoth {
+		return modules, nil
+	}
+
+	var (
+		deduped = make(Modules, 0, len(modules))
+		seen    = make(map[string]*Module, len(modules))
+	)
+
+	for _, module := range modules {
+		first, ok := seen[module.URL()]
+		if !ok {
+			seen[module.URL()] = module
+			deduped = append(deduped, module)
+
+			continue
+		}
+
+		if policy == DuplicateError {
+			return nil, errors.New(&DuplicateModuleError{
+				URL:          module.URL(),
+				FirstSource:  first.TerraformSourcePath(),
+				SecondSource: module.TerraformSourcePath(),
+			})
+		}
+
+		module.Logger().Debugf("Skipping module %q, it resolves to the same URL %q as module %q", module.TerraformSourcePath(), module.URL(), first.TerraformSourcePath())
+	}
+
+	return deduped, nil
+}
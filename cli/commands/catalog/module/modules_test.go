@@ -0,0 +1,205 @@
+package module_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/cli/commands/catalog/module"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModulesWriteReadmes(t *testing.T) {
+	t.Parallel()
+
+	repoPath := t.TempDir()
+
+	readmes := map[string]struct {
+		fileName, content, expectedFileName string
+	}{
+		"modules/vpc":         {"README.md", "# VPC\n\nCreates a VPC.\n", "README.md"},
+		"modules/network/nat": {"README.md", "= NAT Gateway\n\nCreates a NAT gateway.\n", "README.md"},
+		"modules/eks":         {"README.adoc", "= EKS Cluster\n\nCreates an EKS cluster.\n", "README.adoc"},
+		"modules/rds":         {"README.txt", "RDS\n\nCreates an RDS instance.\n", "README.txt"},
+		"modules/no-readme":   {},
+	}
+
+	for moduleDir, readme := range readmes {
+		modulePath := filepath.Join(repoPath, moduleDir)
+
+		require.NoError(t, os.MkdirAll(modulePath, os.ModePerm))
+		require.NoError(t, os.WriteFile(filepath.Join(modulePath, "main.tf"), []byte{}, 0644))
+
+		if readme.fileName != "" {
+			require.NoError(t, os.WriteFile(filepath.Join(modulePath, readme.fileName), []byte(readme.content), 0644))
+		}
+	}
+
+	modules, err := newLocalRepo(t, repoPath).FindModules(context.Background())
+	require.NoError(t, err)
+	require.Len(t, modules, len(readmes))
+
+	outputDir := filepath.Join(t.TempDir(), "site")
+	require.NoError(t, modules.WriteReadmes(outputDir))
+
+	for moduleDir, readme := range readmes {
+		if readme.fileName == "" {
+			entries, err := os.ReadDir(filepath.Join(outputDir, moduleDir))
+			assert.True(t, os.IsNotExist(err), "README written for module without README %q: %v", moduleDir, entries)
+
+			continue
+		}
+
+		content, err := os.ReadFile(filepath.Join(outputDir, moduleDir, readme.expectedFileName))
+		require.NoError(t, err)
+		assert.Equal(t, readme.content, string(content))
+
+		if readme.expectedFileName != "README.md" {
+			assert.NoFileExists(t, filepath.Join(outputDir, moduleDir, "README.md"))
+		}
+	}
+}
+
+func TestModulesFilterByTag(t *testing.T) {
+	t.Parallel()
+
+	repoPath := t.TempDir()
+
+	metadata := map[string]string{
+		"modules/vpc":      `tags = ["network", "aws"]`,
+		"modules/nat":      `tags = ["network"]`,
+		"modules/s3":       `tags = ["aws", "storage"]`,
+		"modules/untagged": "",
+	}
+
+	for moduleDir, content := range metadata {
+		modulePath := filepath.Join(repoPath, moduleDir)
+
+		require.NoError(t, os.MkdirAll(modulePath, os.ModePerm))
+		require.NoError(t, os.WriteFile(filepath.Join(modulePath, "main.tf"), []byte{}, 0644))
+
+		if content != "" {
+			require.NoError(t, os.WriteFile(filepath.Join(modulePath, "catalog-module.hcl"), []byte(content), 0644))
+		}
+	}
+
+	modules, err := newLocalRepo(t, repoPath).FindModules(context.Background())
+	require.NoError(t, err)
+	require.Len(t, modules, 4)
+
+	testCases := []struct {
+		name         string
+		mode         module.TagMatchMode
+		tags         []string
+		expectedDirs []string
+	}{
+		{
+			name:         "single tag",
+			mode:         module.TagMatchAll,
+			tags:         []string{"network"},
+			expectedDirs: []string{"modules/nat", "modules/vpc"},
+		},
+		{
+			name:         "multiple tags with AND",
+			mode:         module.TagMatchAll,
+			tags:         []string{"network", "aws"},
+			expectedDirs: []string{"modules/vpc"},
+		},
+		{
+			name:         "multiple tags with OR",
+			mode:         module.TagMatchAny,
+			tags:         []string{"storage", "network"},
+			expectedDirs: []string{"modules/nat", "modules/s3", "modules/vpc"},
+		},
+		{
+			name:         "unknown tag excludes all modules",
+			mode:         module.TagMatchAny,
+			tags:         []string{"gcp"},
+			expectedDirs: nil,
+		},
+		{
+			name:         "no tags include untagged modules",
+			mode:         module.TagMatchAll,
+			expectedDirs: []string{"modules/nat", "modules/s3", "modules/untagged", "modules/vpc"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var actualDirs []string
+
+			for _, module := range modules.FilterByTag(tc.mode, tc.tags...) {
+				actualDirs = append(actualDirs, module.ModuleDir())
+			}
+
+			assert.Equal(t, tc.expectedDirs, actualDirs)
+		})
+	}
+}
+
+func TestModulesDedup(t *testing.T) {
+	t.Parallel()
+
+	// Both local repositories have the same remote URL, so the modules with the same directory resolve to the same URL.
+	var modules module.Modules
+
+	for _, moduleDirs := range [][]string{{"modules/nat", "modules/vpc"}, {"modules/s3", "modules/vpc"}} {
+		repoPath := t.TempDir()
+
+		for _, moduleDir := range moduleDirs {
+			require.NoError(t, os.MkdirAll(filepath.Join(repoPath, moduleDir), os.ModePerm))
+			require.NoError(t, os.WriteFile(filepath.Join(repoPath, moduleDir, "main.tf"), []byte{}, 0644))
+		}
+
+		repoModules, err := newLocalRepo(t, repoPath).FindModules(context.Background())
+		require.NoError(t, err)
+
+		modules = append(modules, repoModules...)
+	}
+
+	require.Len(t, modules, 4)
+
+	testCases := []struct {
+		policy          module.DuplicatePolicy
+		expectedModules module.Modules
+		expectedErr     bool
+	}{
+		{
+			policy:          module.DuplicateFirstWins,
+			expectedModules: module.Modules{modules[0], modules[1], modules[2]},
+		},
+		{
+			policy:          module.DuplicateKeepBoth,
+			expectedModules: modules,
+		},
+		{
+			policy:      module.DuplicateError,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.policy), func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := modules.Dedup(tc.policy)
+
+			if tc.expectedErr {
+				var duplicateErr *module.DuplicateModuleError
+				require.ErrorAs(t, err, &duplicateErr)
+				assert.Equal(t, modules[1].URL(), duplicateErr.URL)
+				assert.Equal(t, modules[1].TerraformSourcePath(), duplicateErr.FirstSource)
+				assert.Equal(t, modules[3].TerraformSourcePath(), duplicateErr.SecondSource)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedModules, actual)
+		})
+	}
+}
@@ -0,0 +1,258 @@
+package module
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/fs"
+	"maps"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/tf"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-getter"
+)
+
+const (
+	defaultCloneMaxAttempts = 3
+	defaultCloneBaseDelay   = time.Second
+
+	hashedCloneDirNameBytes = 16
+
+	userAgentHeader = "User-Agent"
+)
+
+// GetterFunc downloads the repository from the `src` URL into the `dst` directory.
+type GetterFunc func(ctx context.Context, dst, src string) error
+
+// newDefaultGetter returns the getter downloading the repository using `go-getter`, the HTTP(S) downloads are sent with the given `userAgent`,
+// through the given `proxy`, if set.
+func newDefaultGetter(userAgent string, proxy *proxyConfig) GetterFunc {
+	return func(ctx context.Context, dst, src string) error {
+		getters := maps.Clone(getter.Getters)
+
+		httpGetter := &getter.HttpGetter{
+			Netrc:  true,
+			Header: http.Header{userAgentHeader: []string{userAgent}},
+		}
+
+		if proxy != nil {
+			transport := cleanhttp.DefaultPooledTransport()
+			transport.Proxy = proxy.transportProxy()
+
+			httpGetter.Client = &http.Client{Transport: transport}
+		}
+
+		getters["http"] = httpGetter
+		getters["https"] = httpGetter
+
+		return getter.Get(dst, src, getter.WithContext(ctx), getter.WithMode(getter.ClientModeDir), getter.WithGetters(getters))
+	}
+}
+
+// CloneDirNameFunc returns the path, relative to the temp dir passed to `NewRepo`, of the directory to clone the repository from `cloneURL` into.
+type CloneDirNameFunc func(cloneURL string) string
+
+// RefCloneDirNameFunc is a `CloneDirNameFunc` that also takes the ref to clone, as set by `WithRef`, the `ref` query parameter
+// of the URL, or `HEAD` by default.
+type RefCloneDirNameFunc func(cloneURL, ref string) string
+
+// HashedCloneDirName is a `CloneDirNameFunc` returning the hash of the normalized clone URL and the ref of its `ref` query parameter,
+// prefixed with the repository name, e.g. `terraform-aws-modules-<hash>`. The name is stable across runs and across the different forms
+// of the same repository URL, which makes it suitable for caching the clones between CI runs.
+func HashedCloneDirName(cloneURL string) string {
+	return HashedRefCloneDirName(cloneURL, "")
+}
+
+// HashedRefCloneDirName is a `RefCloneDirNameFunc` like `HashedCloneDirName`, the hash also covers the given `ref`,
+// which takes precedence over the `ref` query parameter of the URL.
+func HashedRefCloneDirName(cloneURL, ref string) string {
+	sum := sha256.Sum256([]byte(cloneKey(cloneURL, ref)))
+	hash := hex.EncodeToString(sum[:hashedCloneDirNameBytes])
+
+	sourceURL, err := tf.ToSourceURL(cloneURL, "")
+	if err != nil {
+		return hash
+	}
+
+	repoPath, _, _ := strings.Cut(sourceURL.Path, "//")
+	repoName := unsafePathCharsReg.ReplaceAllString(path.Base(strings.TrimSuffix(strings.TrimRight(repoPath, "/"), ".git")), "_")
+
+	if strings.Trim(repoName, "._") == "" {
+		return hash
+	}
+
+	return repoName + "-" + hash
+}
+
+// Option is a function to set options for Repo.
+type Option func(repo *Repo)
+
+// WithFS sets the file system, rooted at the repository dir, from which the module dirs, READMEs and metadata files are read.
+// By default, the OS file system is used.
+func WithFS(fsys fs.FS) Option {
+	return func(repo *Repo) {
+		repo.fsys = fsys
+	}
+}
+
+// WithCloneRetry sets the maximum number of clone attempts and the base delay of the exponential backoff between them.
+// Only transient failures, such as DNS errors, connection resets and HTTP 5xx responses, are retried.
+func WithCloneRetry(maxAttempts int, baseDelay time.Duration) Option {
+	return func(repo *Repo) {
+		repo.cloneMaxAttempts = maxAttempts
+		repo.cloneBaseDelay = baseDelay
+	}
+}
+
+// WithOffline enables offline mode, in which a previously completed clone is reused without accessing the network.
+// If the clone is older than `maxAge`, or does not exist, `NewRepo` returns `ErrOfflineCacheMiss`. Zero `maxAge` means the clone never expires.
+func WithOffline(maxAge time.Duration) Option {
+	return func(repo *Repo) {
+		repo.offline = true
+		repo.offlineMaxAge = maxAge
+	}
+}
+
+// WithURLRewriteRules sets the git config `insteadOf` rules applied to the clone URL, e.g. to clone through an internal mirror.
+// By default, the rules are loaded from the system and global git config files, see `DefaultGitConfigPaths`.
+func WithURLRewriteRules(rules *URLRewriteRules) Option {
+	return func(repo *Repo) {
+		repo.urlRewriteRules = rules
+	}
+}
+
+// WithCloneSentinelName sets the name of the sentinel file that marks a completed clone, by default `.catalog-clone-complete`.
+// The file is created next to the repo dir, as `<repo dir>.<name>`, so a custom name lets several tools share the same temp dir.
+func WithCloneSentinelName(name string) Option {
+	return func(repo *Repo) {
+		repo.cloneSentinelName = name
+	}
+}
+
+// WithUserAgent sets the user agent of the HTTP(S) requests of the clones, `terragrunt/<version>` by default. It is sent by the HTTP downloads
+// of the default getter and by the git commands run by the repo, such as the fetches of `Checkout`, via `-c http.userAgent`.
+// The git clones run by `go-getter` use the user agent of git, since `go-getter` does not allow passing config to git.
+func WithUserAgent(userAgent string) Option {
+	return func(repo *Repo) {
+		repo.userAgent = userAgent
+	}
+}
+
+// WithProxy sets the proxies of the HTTP(S) requests of the clones, instead of the proxy env vars of the process, which are left untouched.
+// They are used by the HTTP downloads of the default getter and by the git commands run by the repo, such as the fetches of `Checkout`.
+// The `allProxy` is used for the schemes without their own proxy set, and `noProxy` is a comma-separated list of the hosts accessed
+// directly, as in the `NO_PROXY` env var. The git clones run by `go-getter` use the proxy env vars of the process, since `go-getter`
+// does not allow passing env vars to git.
+func WithProxy(httpProxy, httpsProxy, allProxy, noProxy string) Option {
+	return func(repo *Repo) {
+		repo.proxy = &proxyConfig{
+			httpProxy:  httpProxy,
+			httpsProxy: httpsProxy,
+			allProxy:   allProxy,
+			noProxy:    noProxy,
+		}
+	}
+}
+
+// WithGetter overrides the function used to download remote repositories, by default `go-getter` is used.
+func WithGetter(fn GetterFunc) Option {
+	return func(repo *Repo) {
+		repo.getter = fn
+	}
+}
+
+// WithChecksum pins the downloaded archive to the given checksum in the `go-getter` format `type:value`, e.g. `sha256:<hex>`.
+// If the checksum does not match, `NewRepo` returns `*ChecksumMismatchError`. Only archive sources can be verified by checksum,
+// git repositories should be pinned with `WithExpectedCommitSHA`.
+func WithChecksum(checksum string) Option {
+	return func(repo *Repo) {
+		repo.checksum = checksum
+	}
+}
+
+// WithExpectedCommitSHA pins the repository to the given commit SHA, which can be abbreviated.
+// If the cloned HEAD points to another commit, `NewRepo` returns `*CommitSHAMismatchError`.
+func WithExpectedCommitSHA(sha string) Option {
+	return func(repo *Repo) {
+		repo.expectedCommitSHA = sha
+	}
+}
+
+// WithCloneDirName overrides how the clone directory is named within the temp dir passed to `NewRepo`, e.g. with `HashedCloneDirName`.
+// By default, the directory is named after the repository URL, in the form `<host>/<namespace>/<repo>`.
+// The ref set by `WithRef` is not passed to `fn`, see `WithRefCloneDirName` to name the clones of different refs apart.
+func WithCloneDirName(fn CloneDirNameFunc) Option {
+	return func(repo *Repo) {
+		repo.cloneDirNameFunc = func(cloneURL, _ string) string {
+			return fn(cloneURL)
+		}
+	}
+}
+
+// WithRefCloneDirName is like `WithCloneDirName`, but `fn` also takes the ref to clone, e.g. `HashedRefCloneDirName`.
+func WithRefCloneDirName(fn RefCloneDirNameFunc) Option {
+	return func(repo *Repo) {
+		repo.cloneDirNameFunc = fn
+	}
+}
+
+// WithRef clones the given branch or tag instead of the default branch of the remote repository.
+// It takes precedence over the `ref` query parameter of the repository URL.
+func WithRef(ref string) Option {
+	return func(repo *Repo) {
+		repo.ref = ref
+	}
+}
+
+// WithMaxDepth limits how deep the module directories are searched under the modules paths, e.g. `modules`.
+// Depth 1 searches only the immediate subdirectories, zero means no limit.
+func WithMaxDepth(depth int) Option {
+	return func(repo *Repo) {
+		repo.maxDepth = depth
+	}
+}
+
+// WithRemoteName sets the name of the git remote used to build the module URLs, e.g. "upstream" in a fork-based workflow
+// where "origin" points at the fork. If the repository has no such remote, "origin" is used, then the first remote.
+func WithRemoteName(name string) Option {
+	return func(repo *Repo) {
+		repo.remoteName = name
+	}
+}
+
+// FindOption is a function to set options for `Repo.FindModules`.
+type FindOption func(opts *findOptions)
+
+type findOptions struct {
+	dryScan      bool
+	docsOnlyDirs bool
+}
+
+func newFindOptions(opts []FindOption) *findOptions {
+	findOpts := new(findOptions)
+	for _, opt := range opts {
+		opt(findOpts)
+	}
+
+	return findOpts
+}
+
+// WithDryScan makes `Repo.FindModules` skip reading the README and metadata files of the modules, and return lightweight
+// module stubs with only the paths and URLs set, which is significantly faster for large catalogs.
+func WithDryScan() FindOption {
+	return func(opts *findOptions) {
+		opts.dryScan = true
+	}
+}
+
+// WithDocsOnlyDirs makes `Repo.FindModules` and `NewModule` also return the directories that have a README,
+// but no OpenTofu/Terraform or Terragrunt files, e.g. to list the documentation pages of a catalog.
+func WithDocsOnlyDirs() FindOption {
+	return func(opts *findOptions) {
+		opts.docsOnlyDirs = true
+	}
+}
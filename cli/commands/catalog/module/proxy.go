@@ -0,0 +1,55 @@
+package module
+
+import (
+	"net/http"
+	"net/url"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// proxyConfig is the proxy configuration of a repository, set by `WithProxy`.
+type proxyConfig struct {
+	httpProxy  string
+	httpsProxy string
+	allProxy   string
+	noProxy    string
+}
+
+// env returns the proxy env vars of the git commands. Both upper and lower case names are set, since curl used by git reads
+// only `http_proxy` in lower case, and they override the proxy env vars of the process, even the ones set to empty values.
+func (proxy *proxyConfig) env() []string {
+	return []string{
+		"HTTP_PROXY=" + proxy.httpProxy,
+		"HTTPS_PROXY=" + proxy.httpsProxy,
+		"ALL_PROXY=" + proxy.allProxy,
+		"NO_PROXY=" + proxy.noProxy,
+		"http_proxy=" + proxy.httpProxy,
+		"https_proxy=" + proxy.httpsProxy,
+		"all_proxy=" + proxy.allProxy,
+		"no_proxy=" + proxy.noProxy,
+	}
+}
+
+// transportProxy returns the function selecting the proxy of the HTTP requests, as `http.Transport.Proxy`.
+// The `allProxy` is used for the schemes without their own proxy set.
+func (proxy *proxyConfig) transportProxy() func(req *http.Request) (*url.URL, error) {
+	config := &httpproxy.Config{
+		HTTPProxy:  proxy.httpProxy,
+		HTTPSProxy: proxy.httpsProxy,
+		NoProxy:    proxy.noProxy,
+	}
+
+	if config.HTTPProxy == "" {
+		config.HTTPProxy = proxy.allProxy
+	}
+
+	if config.HTTPSProxy == "" {
+		config.HTTPSProxy = proxy.allProxy
+	}
+
+	proxyFunc := config.ProxyFunc()
+
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}
+}
@@ -2,21 +2,27 @@ package module
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"io/fs"
 	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/gruntwork-io/terragrunt/util"
 
 	"github.com/gitsight/go-vcsurl"
 	"github.com/gruntwork-io/go-commons/files"
+	"github.com/gruntwork-io/go-commons/version"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/gruntwork-io/terragrunt/pkg/log"
 	"github.com/gruntwork-io/terragrunt/tf"
 	"github.com/hashicorp/go-getter"
+	"go.opentelemetry.io/otel/attribute"
 	"gopkg.in/ini.v1"
 )
 
@@ -27,11 +33,43 @@ const (
 	azuredevHost          = "dev.azure.com"
 	bitbucketHost         = "bitbucket.org"
 	gitlabSelfHostedRegex = `^(gitlab\.(.+))$`
+
+	azureDevOpsGitPathSep      = "/_git/"
+	azureDevOpsCloudHostSuffix = ".visualstudio.com"
+
+	gitDirName       = ".git"
+	gitHeadRefPrefix = "ref: "
+	gitBundleExt     = ".bundle"
+	fileURLPrefix    = "file://"
+
+	gitRemoteSectionPrefix = "remote "
+	gitDefaultRemoteName   = "origin"
+
+	cloneLogFieldURL      = "clone-url"
+	cloneLogFieldDir      = "clone-dir"
+	cloneLogFieldDuration = "duration"
+	cloneLogFieldOutcome  = "outcome"
+
+	cloneOutcomeSucceeded = "succeeded"
+	cloneOutcomeFailed    = "failed"
+
+	gitPackedRefsFileName     = "packed-refs"
+	gitPackedRefsPeeledPrefix = "^"
+
+	// cloneCompleteSentinel is the default name of the sentinel file created next to the repo dir, as `<repo dir>.<name>`,
+	// once the clone has been successfully completed.
+	cloneCompleteSentinel         = ".catalog-clone-complete"
+	cloneCompleteSentinelFileMode = 0644
+
+	// cloneRef is the ref the repositories are cloned at by default, the default branch of the remote repository.
+	cloneRef = "HEAD"
 )
 
 var (
+	gitCommitSHAReg         = regexp.MustCompile(`^[0-9a-f]{40}(?:[0-9a-f]{24})?$`)
 	gitHeadBranchNameReg    = regexp.MustCompile(`^.*?([^/]+)$`)
 	repoNameFromCloneURLReg = regexp.MustCompile(`(?i)^.*?([-a-z_.]+)[^/]*?(?:\.git)?$`)
+	unsafePathCharsReg      = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
 
 	modulesPaths = []string{"modules"}
 )
@@ -45,43 +83,232 @@ type Repo struct {
 	RemoteURL  string
 	BranchName string
 
+	// Remotes are the URLs of the git remotes by their names.
+	Remotes map[string]string
+	// remoteName is the name of the remote preferred for `RemoteURL`, set by `WithRemoteName`.
+	remoteName string
+
 	walkWithSymlinks bool
+	maxDepth         int
+
+	getter           GetterFunc
+	cloneDirNameFunc RefCloneDirNameFunc
+	cloneMaxAttempts int
+	cloneBaseDelay   time.Duration
+
+	offline       bool
+	offlineMaxAge time.Duration
+
+	checksum          string
+	expectedCommitSHA string
+	ref               string
+
+	cloneSentinelName string
+
+	urlRewriteRules *URLRewriteRules
+
+	userAgent string
+	proxy     *proxyConfig
+
+	// secretUserinfo is the userinfo of the clone URL that may contain credentials, it is redacted in the logs and errors.
+	secretUserinfo string
+
+	fsys fs.FS
+
+	cloneSource  CloneSource
+	foundModules Modules
+
+	// ownsPath is true if the repository dir was created by this repo instance, only such a dir is removed by `Close`.
+	ownsPath bool
 }
 
-func NewRepo(ctx context.Context, logger log.Logger, cloneURL, tempDir string, walkWithSymlinks bool) (*Repo, error) {
+// NewRepo returns the repository from the given `cloneURL`, which is either a local directory or a remote URL.
+// Remote repositories are cloned into a directory under `tempDir`, named as set by `WithCloneDirName` or `WithRefCloneDirName`.
+func NewRepo(ctx context.Context, logger log.Logger, cloneURL, tempDir string, walkWithSymlinks bool, opts ...Option) (*Repo, error) {
 	repo := &Repo{
-		logger:           logger,
-		cloneURL:         cloneURL,
-		path:             tempDir,
-		walkWithSymlinks: walkWithSymlinks,
+		logger:            logger,
+		cloneURL:          cloneURL,
+		path:              tempDir,
+		walkWithSymlinks:  walkWithSymlinks,
+		cloneMaxAttempts:  defaultCloneMaxAttempts,
+		cloneBaseDelay:    defaultCloneBaseDelay,
+		cloneSentinelName: cloneCompleteSentinel,
+		userAgent:         "terragrunt/" + version.GetVersion(),
 	}
 
-	if err := repo.clone(ctx); err != nil {
+	for _, opt := range opts {
+		opt(repo)
+	}
+
+	if repo.getter == nil {
+		repo.getter = newDefaultGetter(repo.userAgent, repo.proxy)
+	}
+
+	ctx, span := startSpan(ctx, SpanNameNewRepo, attribute.String(SpanAttrRepoURL, cloneURL))
+
+	err := repo.redactErr(repo.init(ctx))
+
+	span.SetAttributes(attribute.String(SpanAttrCloneSource, string(repo.cloneSource)))
+	endSpan(span, err)
+
+	if err != nil {
+		if closeErr := repo.Close(); closeErr != nil {
+			repo.logger.Debugf("Could not remove repo dir %q: %v", repo.path, closeErr)
+		}
+
 		return nil, err
 	}
 
+	return repo, nil
+}
+
+func (repo *Repo) init(ctx context.Context) error {
+	if err := repo.clone(ctx); err != nil {
+		return err
+	}
+
 	if err := repo.parseRemoteURL(); err != nil {
-		return nil, err
+		return err
 	}
 
 	if err := repo.parseBranchName(); err != nil {
-		return nil, err
+		return err
 	}
 
-	return repo, nil
+	return repo.verifyCommitSHA()
+}
+
+// Close removes the repository dir if it was cloned by this repo instance. Local directories and clones reused
+// from the offline cache are never removed. Close is idempotent and safe to defer.
+func (repo *Repo) Close() error {
+	if !repo.ownsPath {
+		return nil
+	}
+
+	repo.logger.Debugf("Removing repo dir %q", repo.path)
+
+	if err := os.RemoveAll(repo.path); err != nil {
+		return errors.New(err)
+	}
+
+	if err := os.Remove(repo.cloneSentinelFile()); err != nil && !os.IsNotExist(err) {
+		return errors.New(err)
+	}
+
+	repo.ownsPath = false
+
+	return nil
+}
+
+// verifyCommitSHA returns `*CommitSHAMismatchError` if the repository HEAD does not point to the commit set by `WithExpectedCommitSHA`.
+func (repo *Repo) verifyCommitSHA() error {
+	if repo.expectedCommitSHA == "" {
+		return nil
+	}
+
+	commitSHA, err := repo.CommitSHA()
+	if err != nil {
+		return err
+	}
+
+	if !strings.HasPrefix(commitSHA, strings.ToLower(repo.expectedCommitSHA)) {
+		return errors.New(&CommitSHAMismatchError{
+			CloneURL: repo.redact(repo.cloneURL),
+			Expected: repo.expectedCommitSHA,
+			Actual:   commitSHA,
+		})
+	}
+
+	return nil
 }
 
 // FindModules clones the repository if `repoPath` is a URL, searches for Terragrunt modules, indexes their README.* files, and returns module instances.
-func (repo *Repo) FindModules(ctx context.Context) (Modules, error) {
-	var modules Modules
+// With `WithDryScan`, the README files are not indexed and lightweight module stubs are returned.
+// If some of the modules cannot be discovered, the rest of the modules are returned along with a `*PartialDiscoveryError`.
+// The same applies if the layout file cannot be read, the modules are then returned without groups, sorted by path.
+func (repo *Repo) FindModules(ctx context.Context, opts ...FindOption) (modules Modules, err error) {
+	_, span := startSpan(ctx, SpanNameFindModules, attribute.String(SpanAttrRepoURL, repo.redact(repo.cloneURL)))
+	defer func() {
+		span.SetAttributes(attribute.Int(SpanAttrModuleCount, len(modules)))
+		endSpan(span, err)
+	}()
+
+	findOpts := newFindOptions(opts)
+
+	newModule := NewModule
+	if findOpts.dryScan {
+		newModule = newModuleStub
+	}
 
-	// check if root repo path is a module dir
-	if module, err := NewModule(repo, ""); err != nil {
-		return nil, err
-	} else if module != nil {
-		modules = append(modules, module)
+	discoveryErr := new(PartialDiscoveryError)
+
+	err = repo.walkModuleDirs(discoveryErr, func(moduleDir string) {
+		if module, err := newModule(repo, moduleDir, opts...); err != nil {
+			discoveryErr.Add(moduleDir, err)
+		} else if module != nil {
+			modules = append(modules, module)
+		}
+	})
+	if err != nil {
+		return modules, err
+	}
+
+	// an invalid layout file does not hide the modules, they are returned in the default order
+	layout, err := ReadLayout(repo.path)
+	if err != nil {
+		discoveryErr.Add(layoutFileName, err)
+	} else if layout != nil {
+		modules = layout.apply(modules)
 	}
 
+	// the stubs lack the README contents, so they are not recorded for the manifest
+	if !findOpts.dryScan {
+		repo.foundModules = modules
+	}
+
+	return modules, discoveryErr.ErrorOrNil()
+}
+
+// fileSystem returns the file system rooted at the repository dir, set by `WithFS`, or the OS file system.
+func (repo *Repo) fileSystem() fs.FS {
+	if repo.fsys != nil {
+		return repo.fsys
+	}
+
+	return os.DirFS(repo.path)
+}
+
+// ListModulePaths returns the directories of the modules relative to the repository root. Unlike `FindModules`,
+// it does not index README files or compute module URLs, which makes it cheap for tooling that needs only the paths.
+func (repo *Repo) ListModulePaths(ctx context.Context) ([]string, error) {
+	var (
+		paths        []string
+		discoveryErr = new(PartialDiscoveryError)
+	)
+
+	err := repo.walkModuleDirs(discoveryErr, func(moduleDir string) {
+		if ok, err := isModuleDir(repo.fileSystem(), fsPath(moduleDir)); err != nil {
+			discoveryErr.Add(moduleDir, err)
+		} else if ok {
+			paths = append(paths, moduleDir)
+		}
+	})
+	if err != nil {
+		return paths, err
+	}
+
+	return paths, discoveryErr.ErrorOrNil()
+}
+
+// walkModuleDirs calls `fn` for the repository root and each directory under the modules paths that may contain a module,
+// the directories are passed relative to the repository root. Walk errors are recorded in `discoveryErr`.
+func (repo *Repo) walkModuleDirs(discoveryErr *PartialDiscoveryError, fn func(moduleDir string)) error {
+	visitedDirs := make(visitedDirs)
+	visitedDirs.visit(repo.path)
+
+	// check if root repo path is a module dir
+	fn("")
+
 	for _, modulesPath := range modulesPaths {
 		modulesPath = filepath.Join(repo.path, modulesPath)
 
@@ -90,39 +317,88 @@ func (repo *Repo) FindModules(ctx context.Context) (Modules, error) {
 		}
 
 		walkFunc := filepath.Walk
+		walkRoot := modulesPath
+
 		if repo.walkWithSymlinks {
 			walkFunc = util.WalkWithSymlinks
+
+			// the walked paths are based on the real path of the root
+			if realPath, err := filepath.EvalSymlinks(modulesPath); err == nil {
+				walkRoot = realPath
+			}
 		}
 
 		err := walkFunc(modulesPath,
 			func(dir string, remote os.FileInfo, err error) error {
+				moduleDir, relErr := filepath.Rel(repo.path, dir)
+				if relErr != nil {
+					return errors.New(relErr)
+				}
+
 				if err != nil {
-					return err
+					discoveryErr.Add(moduleDir, errors.New(err))
+
+					return nil
 				}
 
 				if !remote.IsDir() {
 					return nil
 				}
 
-				moduleDir, err := filepath.Rel(repo.path, dir)
-				if err != nil {
-					return errors.New(err)
+				if filepath.Base(dir) == gitDirName {
+					return filepath.SkipDir
 				}
 
-				if module, err := NewModule(repo, moduleDir); err != nil {
-					return err
-				} else if module != nil {
-					modules = append(modules, module)
+				if repo.maxDepth > 0 && walkDepth(walkRoot, dir) > repo.maxDepth {
+					return filepath.SkipDir
 				}
 
+				// The same directory can be reached multiple times through symlinks pointing back up the tree.
+				if !visitedDirs.visit(dir) {
+					repo.logger.Debugf("Skipping directory %q, it has already been walked", dir)
+
+					return filepath.SkipDir
+				}
+
+				fn(moduleDir)
+
 				return nil
 			})
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
 
-	return modules, nil
+	return nil
+}
+
+// walkDepth returns the depth of the given `dir` relative to the walk `root`, 1 for the immediate subdirectories.
+func walkDepth(root, dir string) int {
+	rel, err := filepath.Rel(root, dir)
+	if err != nil || rel == "." {
+		return 0
+	}
+
+	return len(strings.Split(rel, string(filepath.Separator)))
+}
+
+// visitedDirs tracks walked directories by their real paths.
+type visitedDirs map[string]struct{}
+
+// visit marks the given `dir` as visited and returns false if it has already been visited.
+func (dirs visitedDirs) visit(dir string) bool {
+	realDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		realDir = dir
+	}
+
+	if _, ok := dirs[realDir]; ok {
+		return false
+	}
+
+	dirs[realDir] = struct{}{}
+
+	return true
 }
 
 var githubEnterprisePatternReg = regexp.MustCompile(githubEnterpriseRegex)
@@ -130,10 +406,14 @@ var gitlabSelfHostedPatternReg = regexp.MustCompile(gitlabSelfHostedRegex)
 
 // ModuleURL returns the URL of the module in this repository. `moduleDir` is the path from the repository root.
 func (repo *Repo) ModuleURL(moduleDir string) (string, error) {
-	if repo.RemoteURL == "" {
+	if repo.RemoteURL == "" || repo.cloneSource == CloneSourceBundle {
 		return filepath.Join(repo.path, moduleDir), nil
 	}
 
+	if serverURL, ok := azureDevOpsServerBrowseURL(repo.RemoteURL); ok {
+		return fmt.Sprintf("%s?path=%s&version=GB%s", serverURL, moduleDir, repo.BranchName), nil
+	}
+
 	remote, err := vcsurl.Parse(repo.RemoteURL)
 	if err != nil {
 		return "", errors.New(err)
@@ -163,8 +443,56 @@ func (repo *Repo) ModuleURL(moduleDir string) (string, error) {
 	return "", errors.Errorf("hosting: %q is not supported yet", remote.Host)
 }
 
+// azureDevOpsServerBrowseURL returns the browse URL of the repository hosted on an on-prem Azure DevOps Server, e.g.
+// `https://tfs.acme.com/tfs/DefaultCollection/Project/_git/Repo`. The collection path can have any number of segments,
+// so it is taken from the remote URL as is, everything before the project. Returns false if the remote URL is not in this form,
+// or is hosted on Azure DevOps cloud.
+func azureDevOpsServerBrowseURL(remoteURL string) (string, bool) {
+	remote, err := url.Parse(remoteURL)
+	if err != nil || remote.Host == "" {
+		return "", false
+	}
+
+	if host := remote.Hostname(); host == azuredevHost || strings.HasSuffix(host, azureDevOpsCloudHostSuffix) {
+		return "", false
+	}
+
+	projectPath, repoName, ok := strings.Cut(remote.Path, azureDevOpsGitPathSep)
+	if !ok || repoName == "" || strings.Contains(repoName, "/") {
+		return "", false
+	}
+
+	segments := strings.Split(strings.Trim(projectPath, "/"), "/")
+
+	// at least one collection segment and the project
+	if len(segments) < 2 || slices.Contains(segments, "") { //nolint:mnd
+		return "", false
+	}
+
+	scheme, host := remote.Scheme, remote.Host
+	if scheme != "http" && scheme != "https" {
+		// SSH remote, its port is not the port of the web interface
+		scheme, host = "https", remote.Hostname()
+	}
+
+	return fmt.Sprintf("%s://%s/%s%s%s", scheme, host, strings.Join(segments, "/"), azureDevOpsGitPathSep, repoName), true
+}
+
 // clone clones the repository to a temporary directory if the repoPath is URL
 func (repo *Repo) clone(ctx context.Context) error {
+	cloneURL, err := expandCloneURL(repo.cloneURL)
+	if err != nil {
+		return err
+	}
+
+	if cloneURL != repo.cloneURL {
+		repo.logger.Debugf("Expanded env vars in clone URL %q", repo.cloneURL)
+		repo.secretUserinfo = secretUserinfo(repo.cloneURL, cloneURL)
+		repo.cloneURL = cloneURL
+	} else {
+		repo.secretUserinfo = secretUserinfo(cloneURL, cloneURL)
+	}
+
 	if repo.cloneURL == "" {
 		currentDir, err := os.Getwd()
 		if err != nil {
@@ -174,6 +502,10 @@ func (repo *Repo) clone(ctx context.Context) error {
 		repo.cloneURL = currentDir
 	}
 
+	if localPath, ok := strings.CutPrefix(repo.cloneURL, fileURLPrefix); ok && files.FileExists(localPath) {
+		repo.cloneURL = localPath
+	}
+
 	if repoPath := repo.cloneURL; files.IsDir(repoPath) {
 		if !filepath.IsAbs(repoPath) {
 			absRepoPath, err := filepath.Abs(repoPath)
@@ -185,20 +517,27 @@ func (repo *Repo) clone(ctx context.Context) error {
 		}
 
 		repo.path = repoPath
+		repo.cloneSource = CloneSourceLocal
 
 		return nil
 	}
 
-	if err := os.MkdirAll(repo.path, os.ModePerm); err != nil {
-		return errors.New(err)
+	sourceURL, err := tf.ToSourceURL(repo.cloneURL, "")
+	if err != nil {
+		return err
 	}
 
-	repoName := "temp"
-	if match := repoNameFromCloneURLReg.FindStringSubmatch(repo.cloneURL); len(match) > 0 && match[1] != "" {
-		repoName = match[1]
+	ref := resolveRef(repo.ref, sourceURL)
+
+	if repo.cloneDirNameFunc != nil {
+		repo.path = filepath.Join(repo.path, repo.cloneDirNameFunc(repo.cloneURL, ref))
+	} else {
+		repo.path = filepath.Join(repo.path, cloneDirName(repo.cloneURL))
 	}
 
-	repo.path = filepath.Join(repo.path, repoName)
+	if err := os.MkdirAll(filepath.Dir(repo.path), os.ModePerm); err != nil {
+		return errors.New(err)
+	}
 
 	// Since we are cloning the repository into a temporary directory, some operating systems such as MacOS have a service for deleting files that have not been accessed for a long time.
 	// For example, in MacOS the service is responsible for deleting unused files deletes only files while leaving the directory structure is untouched, which in turn misleads `go-getter`, which thinks that the repository exists but cannot update it due to the lack of files. In such cases, we simply delete the temporary directory in order to clone the one again.
@@ -211,33 +550,263 @@ func (repo *Repo) clone(ctx context.Context) error {
 		}
 	}
 
-	sourceURL, err := tf.ToSourceURL(repo.cloneURL, "")
-	if err != nil {
-		return err
+	if filepath.Ext(repo.cloneURL) == gitBundleExt && files.FileExists(repo.cloneURL) {
+		return repo.cloneBundle(ctx)
+	}
+
+	if !repo.offline {
+		if sourceURL, err = repo.rewriteSourceURL(sourceURL); err != nil {
+			return err
+		}
 	}
 
 	repo.cloneURL = sourceURL.String()
 
-	repo.logger.Infof("Cloning repository %q to temporary directory %q", repo.cloneURL, repo.path)
+	if repo.offline {
+		repo.cloneSource = CloneSourceOfflineCache
+
+		return repo.checkOfflineCache()
+	}
+
+	repo.cloneSource = CloneSourceGetter
+
+	switch {
+	case !files.FileExists(repo.path):
+		repo.logger.Infof("Cloning repository %q to temporary directory %q", repo.redact(repo.cloneURL), repo.path)
+	case repo.canUpdateClone(ref):
+		repo.logger.Infof("Updating repository %q in temporary directory %q", repo.redact(repo.cloneURL), repo.path)
+	default:
+		repo.logger.Debugf("The repo dir %q does not contain a completed clone of %q. Removing the repo dir for cloning from scratch.", repo.path, repo.redact(repo.cloneURL))
+
+		if err := os.RemoveAll(repo.path); err != nil {
+			return errors.New(err)
+		}
+
+		repo.logger.Infof("Cloning repository %q to temporary directory %q", repo.redact(repo.cloneURL), repo.path)
+	}
+
+	// An existing clone that is updated may be shared with other repo instances, so only a dir cloned from scratch is removed by `Close`.
+	repo.ownsPath = !files.FileExists(repo.path)
 
 	// We need to explicitly specify the reference, otherwise we will get an error:
 	// "fatal: The empty string is not a valid pathspec. Use . instead if you wanted to match all paths"
 	// when updating an existing repository.
-	sourceURL.RawQuery = (url.Values{"ref": []string{"HEAD"}}).Encode()
+	query := url.Values{"ref": []string{ref}}
+
+	if repo.checksum != "" {
+		query.Set("checksum", repo.checksum)
+	}
+
+	sourceURL.RawQuery = query.Encode()
 
-	if err := getter.Get(repo.path, strings.Trim(sourceURL.String(), "/"), getter.WithContext(ctx), getter.WithMode(getter.ClientModeDir)); err != nil {
+	// The sentinel is outside the repo dir, so it is not removed along with an incomplete clone. Remove it explicitly,
+	// so that the clone is not considered completed if it fails.
+	if err := os.Remove(repo.cloneSentinelFile()); err != nil && !os.IsNotExist(err) {
 		return errors.New(err)
 	}
 
+	if err := repo.performClone(ctx, strings.Trim(sourceURL.String(), "/")); err != nil {
+		return err
+	}
+
+	return repo.writeCloneSentinel(ref)
+}
+
+// redact replaces the credentials of the clone URL in the given string, so that they do not leak into the logs and errors.
+func (repo *Repo) redact(str string) string {
+	if repo.secretUserinfo == "" {
+		return str
+	}
+
+	return strings.ReplaceAll(str, repo.secretUserinfo+"@", redactedUserinfo+"@")
+}
+
+// redactErr returns the given error with the credentials of the clone URL replaced in its message, see `redact`.
+func (repo *Repo) redactErr(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if msg := repo.redact(err.Error()); msg != err.Error() {
+		return &redactedError{err: err, msg: msg}
+	}
+
+	return err
+}
+
+// expandCloneURL replaces the `${VAR}` and `$VAR` references in the given clone URL with the values of the env vars,
+// so that the same URL can be used across environments, e.g. `https://${GIT_HOST}/acme/modules.git`.
+// If any of the referenced env vars is not defined, `UndefinedCloneURLVarsError` is returned.
+func expandCloneURL(cloneURL string) (string, error) {
+	var undefinedVars []string
+
+	expanded := os.Expand(cloneURL, func(name string) string {
+		val, ok := os.LookupEnv(name)
+		if !ok && !slices.Contains(undefinedVars, name) {
+			undefinedVars = append(undefinedVars, name)
+		}
+
+		return val
+	})
+
+	if len(undefinedVars) > 0 {
+		return "", errors.New(UndefinedCloneURLVarsError{CloneURL: cloneURL, Vars: undefinedVars})
+	}
+
+	return expanded, nil
+}
+
+// resolveRef returns the ref to clone: the given `ref` set by `WithRef`, or the `ref` query parameter of the URL. By default, the remote `HEAD`
+// is cloned, which git resolves to the default branch of the remote repository, whatever its name is, e.g. `develop`.
+func resolveRef(ref string, sourceURL *url.URL) string {
+	if ref != "" {
+		return ref
+	}
+
+	if ref := sourceURL.Query().Get("ref"); ref != "" {
+		return ref
+	}
+
+	return cloneRef
+}
+
+// cloneBundle clones the repository from the git bundle file `repo.cloneURL` using the git CLI, since `go-getter` does not support bundles.
+// The bundle is local, so any previous clone is removed and the repository is always cloned again.
+func (repo *Repo) cloneBundle(ctx context.Context) error {
+	repo.cloneSource = CloneSourceBundle
+	repo.ownsPath = true
+
+	if err := os.RemoveAll(repo.path); err != nil {
+		return errors.New(err)
+	}
+
+	repo.logger.Infof("Cloning repository from bundle %q to temporary directory %q", repo.cloneURL, repo.path)
+
+	err := repo.performCloneWith(ctx, func(ctx context.Context) error {
+		if _, err := repo.runGitIn(ctx, "", "clone", "--quiet", repo.cloneURL, repo.path); err != nil {
+			return errors.Errorf("clone %q: %w", repo.cloneURL, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return repo.writeCloneSentinel(cloneRef)
+}
+
+// cloneDirName returns the relative path of the directory to clone the repository into, in the form `<host>/<namespace>/<repo>`,
+// so that repositories with the same name from different organizations do not collide. The path is built from the normalized URL,
+// see `NormalizeCloneURL`, so the different forms of the same repository URL share one directory.
+func cloneDirName(cloneURL string) string {
+	var segments []string
+
+	if normalizedURL, err := NormalizeCloneURL(cloneURL); err == nil {
+		if sourceURL, err := url.Parse(normalizedURL); err == nil {
+			repoPath, _, _ := strings.Cut(sourceURL.Path, "//")
+
+			for _, segment := range append([]string{sourceURL.Hostname()}, strings.Split(repoPath, "/")...) {
+				segment = unsafePathCharsReg.ReplaceAllString(segment, "_")
+
+				if segment != "" && segment != "." && segment != ".." {
+					segments = append(segments, segment)
+				}
+			}
+		}
+	}
+
+	if len(segments) > 0 {
+		return filepath.Join(segments...)
+	}
+
+	if match := repoNameFromCloneURLReg.FindStringSubmatch(cloneURL); len(match) > 0 && match[1] != "" {
+		return match[1]
+	}
+
+	return "temp"
+}
+
+// checkOfflineCache returns `ErrOfflineCacheMiss` if there is no completed clone of the repository, or the clone is older than the configured max age.
+func (repo *Repo) checkOfflineCache() error {
+	info, err := os.Stat(repo.cloneSentinelFile())
+	if err != nil {
+		return errors.Errorf("%w: %q", ErrOfflineCacheMiss, repo.path)
+	}
+
+	if age := time.Since(info.ModTime()); repo.offlineMaxAge > 0 && age > repo.offlineMaxAge {
+		return errors.Errorf("%w: clone %q is %s old, max age is %s", ErrOfflineCacheMiss, repo.path, age.Round(time.Second), repo.offlineMaxAge)
+	}
+
+	repo.logger.Infof("Offline mode, using cached clone of repository %q in %q", repo.redact(repo.cloneURL), repo.path)
+
+	return nil
+}
+
+// cloneSentinelFile returns the path of the clone sentinel file. The file is stored next to the repo dir rather than in it,
+// so that it neither pollutes the worktree nor collides with a file of the repository.
+func (repo *Repo) cloneSentinelFile() string {
+	return repo.path + "." + strings.TrimLeft(repo.cloneSentinelName, ".")
+}
+
+// performClone downloads the repository from the given `sourceURL`, retrying on transient failures.
+// The start and the outcome of the clone are logged with the URL, the target dir, and the duration fields.
+func (repo *Repo) performClone(ctx context.Context, sourceURL string) error {
+	return repo.performCloneWith(ctx, func(ctx context.Context) error {
+		return repo.withCloneRetry(ctx, func(ctx context.Context) error {
+			return repo.getter(ctx, repo.path, sourceURL)
+		})
+	})
+}
+
+// performCloneWith runs the given clone function `fn`, logging its start and outcome.
+func (repo *Repo) performCloneWith(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	logger := repo.logger.WithFields(log.Fields{
+		cloneLogFieldURL: repo.redact(repo.cloneURL),
+		cloneLogFieldDir: repo.path,
+	})
+
+	logger.Debugf("Clone started")
+
+	ctx, span := startSpan(ctx, SpanNameClone,
+		attribute.String(SpanAttrRepoURL, repo.redact(repo.cloneURL)),
+		attribute.String(SpanAttrCloneSource, string(repo.cloneSource)),
+	)
+	defer func() { endSpan(span, err) }()
+
+	startTime := time.Now()
+
+	err = fn(ctx)
+
+	logger = logger.WithField(cloneLogFieldDuration, time.Since(startTime).Round(time.Millisecond).String())
+
+	if err != nil {
+		logger.WithField(cloneLogFieldOutcome, cloneOutcomeFailed).Debugf("Clone failed: %v", repo.redactErr(err))
+
+		var checksumErr *getter.ChecksumError
+		if errors.As(err, &checksumErr) {
+			return errors.New(&ChecksumMismatchError{
+				CloneURL: repo.redact(repo.cloneURL),
+				Expected: hex.EncodeToString(checksumErr.Expected),
+				Actual:   hex.EncodeToString(checksumErr.Actual),
+			})
+		}
+
+		return classifyCloneError(err)
+	}
+
+	logger.WithField(cloneLogFieldOutcome, cloneOutcomeSucceeded).Debugf("Clone done")
+
 	return nil
 }
 
-// parseRemoteURL reads the git config `.git/config` and parses the first URL of the remote URLs, the remote name "origin" has the highest priority.
+// parseRemoteURL reads the git config `.git/config` and parses the URLs of the remotes into `Remotes`.
+// `RemoteURL` is set to the URL of the remote set by `WithRemoteName`, falling back to "origin", then to the first remote.
 func (repo *Repo) parseRemoteURL() error {
-	gitConfigPath := filepath.Join(repo.path, ".git", "config")
+	gitConfigPath := filepath.Join(repo.path, gitDirName, "config")
 
 	if !files.FileExists(gitConfigPath) {
-		return errors.Errorf("the specified path %q is not a git repository", repo.path)
+		return errors.Errorf("the specified path %q is %w", repo.path, ErrNotGitRepo)
 	}
 
 	repo.logger.Debugf("Parsing git config %q", gitConfigPath)
@@ -247,40 +816,59 @@ func (repo *Repo) parseRemoteURL() error {
 		return errors.New(err)
 	}
 
-	var sectionName string
+	var firstRemoteName string
 
-	for _, name := range inidata.SectionStrings() {
-		if !strings.HasPrefix(name, "remote") {
+	repo.Remotes = make(map[string]string)
+
+	for _, section := range inidata.Sections() {
+		name, ok := strings.CutPrefix(section.Name(), gitRemoteSectionPrefix)
+		if !ok {
 			continue
 		}
 
-		sectionName = name
+		name = strings.Trim(strings.TrimSpace(name), `"`)
 
-		if sectionName == `remote "origin"` {
-			break
+		repo.Remotes[name] = section.Key("url").String()
+
+		if firstRemoteName == "" {
+			firstRemoteName = name
 		}
 	}
 
 	// no git remotes found
-	if sectionName == "" {
+	if firstRemoteName == "" {
 		return nil
 	}
 
-	repo.RemoteURL = inidata.Section(sectionName).Key("url").String()
+	remoteName := firstRemoteName
+
+	for _, name := range []string{repo.remoteName, gitDefaultRemoteName} {
+		if _, ok := repo.Remotes[name]; ok && name != "" {
+			remoteName = name
+
+			break
+		}
+	}
+
+	if repo.remoteName != "" && remoteName != repo.remoteName {
+		repo.logger.Debugf("Remote %q not found for repo %q, using remote %q", repo.remoteName, repo.path, remoteName)
+	}
+
+	repo.RemoteURL = repo.Remotes[remoteName]
 	repo.logger.Debugf("Remote url: %q for repo: %q", repo.RemoteURL, repo.path)
 
 	return nil
 }
 
 func (repo *Repo) gitHeadfile() string {
-	return filepath.Join(repo.path, ".git", "HEAD")
+	return filepath.Join(repo.path, gitDirName, "HEAD")
 }
 
 // parseBranchName reads `.git/HEAD` file and parses a branch name.
 func (repo *Repo) parseBranchName() error {
 	data, err := files.ReadFileAsString(repo.gitHeadfile())
 	if err != nil {
-		return errors.Errorf("the specified path %q is not a git repository", repo.path)
+		return errors.Errorf("the specified path %q is %w", repo.path, ErrNotGitRepo)
 	}
 
 	if match := gitHeadBranchNameReg.FindStringSubmatch(data); len(match) > 0 {
@@ -290,3 +878,80 @@ func (repo *Repo) parseBranchName() error {
 
 	return errors.Errorf("could not get branch name for repo %q", repo.path)
 }
+
+// CommitSHA resolves the commit SHA of `.git/HEAD`, either directly from a detached HEAD or through the referenced
+// branch in `.git/refs` or `.git/packed-refs`. Returns `*RefNotFoundError` if the referenced branch does not exist,
+// for example, if it does not have any commits yet.
+func (repo *Repo) CommitSHA() (string, error) {
+	data, err := files.ReadFileAsString(repo.gitHeadfile())
+	if err != nil {
+		return "", errors.Errorf("the specified path %q is %w", repo.path, ErrNotGitRepo)
+	}
+
+	head := strings.TrimSpace(data)
+
+	if gitCommitSHAReg.MatchString(head) {
+		return head, nil
+	}
+
+	ref, ok := strings.CutPrefix(head, gitHeadRefPrefix)
+	if !ok {
+		return "", errors.Errorf("could not parse HEAD %q for repo %q", head, repo.path)
+	}
+
+	ref = strings.TrimSpace(ref)
+
+	if data, err := files.ReadFileAsString(filepath.Join(repo.path, gitDirName, filepath.FromSlash(ref))); err == nil {
+		return strings.TrimSpace(data), nil
+	}
+
+	sha, err := repo.resolvePackedRef(ref)
+	if err != nil {
+		return "", err
+	}
+
+	if sha == "" {
+		return "", errors.New(&RefNotFoundError{Ref: ref, RepoPath: repo.path})
+	}
+
+	return sha, nil
+}
+
+// resolvePackedRef returns the commit SHA of the given `ref` from `.git/packed-refs`, or an empty string if the ref is not there.
+// For annotated tags, the SHA of the peeled commit, listed on the `^` line that follows the tag, is returned.
+func (repo *Repo) resolvePackedRef(ref string) (string, error) {
+	packedRefsPath := filepath.Join(repo.path, gitDirName, gitPackedRefsFileName)
+
+	if !files.FileExists(packedRefsPath) {
+		return "", nil
+	}
+
+	data, err := files.ReadFileAsString(packedRefsPath)
+	if err != nil {
+		return "", errors.New(err)
+	}
+
+	var sha string
+
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+
+		if peeled, ok := strings.CutPrefix(line, gitPackedRefsPeeledPrefix); ok {
+			if sha != "" && gitCommitSHAReg.MatchString(peeled) {
+				return peeled, nil
+			}
+
+			continue
+		}
+
+		if sha != "" {
+			break
+		}
+
+		if lineSHA, name, ok := strings.Cut(line, " "); ok && name == ref && gitCommitSHAReg.MatchString(lineSHA) {
+			sha = lineSHA
+		}
+	}
+
+	return sha, nil
+}
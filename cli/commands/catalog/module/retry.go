@@ -0,0 +1,124 @@
+package module
+
+import (
+	"context"
+	"math/rand/v2"
+	"net"
+	"regexp"
+	"syscall"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+)
+
+// maxCloneBackoff is the maximum delay between the clone retries, without the jitter.
+const maxCloneBackoff = time.Minute
+
+var (
+	// transientCloneErrorReg matches the messages of `git` and HTTP errors that are worth retrying.
+	transientCloneErrorReg = regexp.MustCompile(`(?i)(could not resolve host|no such host|connection reset|connection refused|connection timed out|i/o timeout|tls handshake timeout|unexpected disconnect|early eof|the remote end hung up unexpectedly|returned error: 5\d\d|bad response code: 5\d\d|\b50[234] )`)
+
+	// authCloneErrorReg matches the messages of `git` and HTTP errors caused by missing or invalid credentials.
+	authCloneErrorReg = regexp.MustCompile(`(?i)(authentication failed|permission denied \(publickey|could not read username|could not read password|returned error: 40[13]|bad response code: 40[13])`)
+
+	// diskFullCloneErrorReg matches the messages of errors caused by running out of disk space in a `git` subprocess.
+	diskFullCloneErrorReg = regexp.MustCompile(`(?i)(no space left on device|disk quota exceeded)`)
+
+	// permanentCloneErrorReg matches the messages of errors that should never be retried, even if they also look transient.
+	permanentCloneErrorReg = regexp.MustCompile(`(?i)(authentication failed|permission denied|could not read username|repository not found|returned error: 40[134]|bad response code: 40[134])`)
+)
+
+// isTransientCloneError returns true if the given clone error is caused by a temporary network issue, such as DNS errors, connection resets, HTTP 5xx.
+func isTransientCloneError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	msg := err.Error()
+
+	if permanentCloneErrorReg.MatchString(msg) {
+		return false
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return transientCloneErrorReg.MatchString(msg)
+}
+
+// classifyCloneError wraps the given clone error with one of `ErrCloneAuth`, `ErrCloneDiskFull` or `ErrCloneNetwork`,
+// if it falls into one of these categories.
+func classifyCloneError(err error) error {
+	msg := err.Error()
+
+	switch {
+	case authCloneErrorReg.MatchString(msg):
+		return errors.Errorf("%w: %w", ErrCloneAuth, err)
+	case errors.Is(err, syscall.ENOSPC) || diskFullCloneErrorReg.MatchString(msg):
+		return errors.Errorf("%w: %w", ErrCloneDiskFull, err)
+	case isTransientCloneError(err):
+		return errors.Errorf("%w: %w", ErrCloneNetwork, err)
+	}
+
+	return errors.New(err)
+}
+
+// cloneBackoff returns the delay before the given retry `attempt` (starting at 1), it grows exponentially from `baseDelay` with added jitter,
+// and is capped at `maxCloneBackoff`, without the jitter.
+func cloneBackoff(baseDelay time.Duration, attempt int) time.Duration {
+	if baseDelay <= 0 {
+		return 0
+	}
+
+	delay := min(baseDelay, maxCloneBackoff)
+
+	for i := 1; i < attempt && delay < maxCloneBackoff; i++ {
+		delay = min(delay*2, maxCloneBackoff) //nolint:mnd
+	}
+
+	return delay + rand.N(delay/2+1) //nolint:gosec
+}
+
+// withCloneRetry runs the given `action` until it succeeds, returns a non-transient error or the number of attempts is exhausted.
+func (repo *Repo) withCloneRetry(ctx context.Context, action func(ctx context.Context) error) error {
+	maxAttempts := max(repo.cloneMaxAttempts, 1)
+
+	var err error
+
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if err = action(ctx); err == nil {
+			return nil
+		}
+
+		if attempt == maxAttempts || !isTransientCloneError(err) {
+			break
+		}
+
+		delay := cloneBackoff(repo.cloneBaseDelay, attempt)
+
+		repo.logger.Debugf("Cloning repository %q failed with transient error: %v. Retry %d of %d in %s.", repo.redact(repo.cloneURL), repo.redactErr(err), attempt, maxAttempts-1, delay)
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return errors.New(ctx.Err())
+		}
+	}
+
+	return err
+}
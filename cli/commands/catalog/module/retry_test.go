@@ -0,0 +1,58 @@
+package module
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloneBackoff(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		baseDelay time.Duration
+		attempt   int
+		expected  time.Duration
+	}{
+		{"first attempt", time.Second, 1, time.Second},
+		{"exponential growth", time.Second, 4, 8 * time.Second},
+		{"capped", time.Second, 10, maxCloneBackoff},
+		{"large attempt", time.Second, 1000, maxCloneBackoff},
+		{"large base delay", math.MaxInt64, 2, maxCloneBackoff},
+		{"disabled", 0, 1000, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			delay := cloneBackoff(tc.baseDelay, tc.attempt)
+
+			assert.GreaterOrEqual(t, delay, tc.expected)
+			assert.LessOrEqual(t, delay, tc.expected+tc.expected/2)
+		})
+	}
+}
+
+func TestIsTransientCloneError(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		msg      string
+		expected bool
+	}{
+		{"fatal: unable to access 'https://github.com/acme/repo.git/': Could not resolve host: github.com (host not found)", true},
+		{"fatal: repository 'https://github.com/acme/repo.git/' not found", false},
+		{"remote: Repository not found.", false},
+		{"fatal: unable to access: The requested URL returned error: 404", false},
+		{"fatal: unable to access: The requested URL returned error: 503", true},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, isTransientCloneError(errors.New(tc.msg)), tc.msg)
+	}
+}
@@ -0,0 +1,138 @@
+package module
+
+import (
+	"context"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/progress"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+// RepoStatus is the status of a repository reported by `ScanRepos`.
+type RepoStatus string
+
+const (
+	// RepoStatusCloning means the repository is being cloned.
+	RepoStatusCloning RepoStatus = "cloning"
+	// RepoStatusDone means the modules of the repository have been found.
+	RepoStatusDone RepoStatus = "done"
+	// RepoStatusFailed means the repository could not be cloned or scanned, the error is set in `RepoEvent.Err`.
+	RepoStatusFailed RepoStatus = "failed"
+)
+
+// RepoEvent reports the status of a repository while it is scanned.
+type RepoEvent struct {
+	RepoURL string
+	Status  RepoStatus
+	Err     error
+}
+
+// ScanOptions configures `ScanRepos`.
+type ScanOptions struct {
+	// TempDirFunc returns the directory to clone the given repository into.
+	TempDirFunc func(repoURL string) string
+	// Events, if set, receives the status of each repository as it is scanned. The channel is not closed by `ScanRepos`.
+	Events chan<- RepoEvent
+	// CloneCoordinator, if set, shares the clones with other scans of the run. Otherwise, the clones are shared only within the scan.
+	CloneCoordinator *CloneCoordinator
+	// RepoOptions are passed to `NewRepo` for each repository.
+	RepoOptions []Option
+	// WalkWithSymlinks makes module discovery follow symlinks.
+	WalkWithSymlinks bool
+	// ContinueOnError skips failed repositories instead of aborting the scan.
+	ContinueOnError bool
+}
+
+// ScanRepos clones the given repositories and returns the modules found in them. Partial discovery errors are logged as warnings.
+// By default, the first failed repository aborts the scan. With `ContinueOnError`, failed repositories are skipped and their errors
+// are collected into a `*errors.MultiError` returned along with the modules of the rest of the repositories.
+// URLs referencing the same repository are cloned and scanned once, see `CloneCoordinator`.
+func ScanRepos(ctx context.Context, logger log.Logger, repoURLs []string, scanOpts ScanOptions) (Modules, error) {
+	var (
+		modules Modules
+		errs    *errors.MultiError
+		scanned = make(map[*Repo]error)
+	)
+
+	if scanOpts.CloneCoordinator == nil {
+		scanOpts.CloneCoordinator = NewCloneCoordinator()
+	}
+
+	for _, repoURL := range repoURLs {
+		repoModules, err := scanRepo(ctx, logger, repoURL, scanOpts, scanned)
+		if err != nil {
+			sendRepoEvent(ctx, scanOpts.Events, RepoEvent{RepoURL: repoURL, Status: RepoStatusFailed, Err: err})
+
+			if !scanOpts.ContinueOnError {
+				return nil, err
+			}
+
+			logger.Warnf("Skipping repository %q: %v", repoURL, err)
+
+			errs = errs.Append(errors.Errorf("repository %q: %w", repoURL, err))
+
+			continue
+		}
+
+		sendRepoEvent(ctx, scanOpts.Events, RepoEvent{RepoURL: repoURL, Status: RepoStatusDone})
+
+		modules = append(modules, repoModules...)
+
+		progress.EmitterFromContext(ctx).Progress(repoURL)
+	}
+
+	return modules, errs.ErrorOrNil()
+}
+
+// scanRepo returns the modules of the given repository. The repositories already scanned are recorded in `scanned`
+// along with their errors, such repositories are not scanned again and no modules are returned for them.
+func scanRepo(ctx context.Context, logger log.Logger, repoURL string, scanOpts ScanOptions, scanned map[*Repo]error) (Modules, error) {
+	sendRepoEvent(ctx, scanOpts.Events, RepoEvent{RepoURL: repoURL, Status: RepoStatusCloning})
+
+	var tempDir string
+	if scanOpts.TempDirFunc != nil {
+		tempDir = scanOpts.TempDirFunc(repoURL)
+	}
+
+	repo, err := scanOpts.CloneCoordinator.Repo(ctx, logger, repoURL, "", tempDir, scanOpts.WalkWithSymlinks, scanOpts.RepoOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err, ok := scanned[repo]; ok {
+		logger.Debugf("Repository %q has already been scanned", repoURL)
+
+		return nil, err
+	}
+
+	modules, err := repo.FindModules(ctx)
+	if err != nil {
+		var discoveryErr *PartialDiscoveryError
+		if !errors.As(err, &discoveryErr) {
+			err = errors.Join(err, repo.Close())
+			scanned[repo] = err
+
+			return nil, err
+		}
+
+		logger.Warnf("Some modules in repository %q could not be discovered: %v", repoURL, err)
+	}
+
+	scanned[repo] = nil
+
+	logger.Infof("Found %d modules in repository %q", len(modules), repoURL)
+
+	return modules, nil
+}
+
+// sendRepoEvent sends the event to the channel if it is set, unless the context is canceled.
+func sendRepoEvent(ctx context.Context, events chan<- RepoEvent, event RepoEvent) {
+	if events == nil {
+		return
+	}
+
+	select {
+	case events <- event:
+	case <-ctx.Done():
+	}
+}
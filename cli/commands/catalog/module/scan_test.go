@@ -0,0 +1,102 @@
+package module_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/cli/commands/catalog/module"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanRepos(t *testing.T) {
+	t.Parallel()
+
+	repoURLs := []string{
+		"https://github.com/acme/terraform-aws-network.git",
+		"https://github.com/acme/terraform-aws-broken.git",
+		"https://github.com/acme/terraform-aws-data.git",
+	}
+
+	errClone := errors.New("repository not found")
+
+	fakeGetter := func(_ context.Context, dst, src string) error {
+		if strings.Contains(src, "broken") {
+			return errClone
+		}
+
+		if err := os.MkdirAll(filepath.Join(dst, "modules", "vpc"), os.ModePerm); err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(filepath.Join(dst, "modules", "vpc", "main.tf"), []byte{}, 0644); err != nil {
+			return err
+		}
+
+		remoteURL, _, _ := strings.Cut(strings.TrimPrefix(src, "git::"), "?")
+
+		return writeGitDir(t, dst, remoteURL)
+	}
+
+	scanOpts := func(t *testing.T, continueOnError bool, events chan<- module.RepoEvent) module.ScanOptions {
+		t.Helper()
+
+		tempDir := t.TempDir()
+
+		return module.ScanOptions{
+			TempDirFunc:     func(string) string { return tempDir },
+			Events:          events,
+			RepoOptions:     []module.Option{module.WithGetter(fakeGetter), module.WithCloneRetry(1, time.Millisecond)},
+			ContinueOnError: continueOnError,
+		}
+	}
+
+	t.Run("abort on error", func(t *testing.T) {
+		t.Parallel()
+
+		modules, err := module.ScanRepos(context.Background(), log.New(), repoURLs, scanOpts(t, false, nil))
+		require.ErrorIs(t, err, errClone)
+		assert.Empty(t, modules)
+	})
+
+	t.Run("continue on error", func(t *testing.T) {
+		t.Parallel()
+
+		events := make(chan module.RepoEvent, len(repoURLs)*2)
+
+		modules, err := module.ScanRepos(context.Background(), log.New(), repoURLs, scanOpts(t, true, events))
+		require.ErrorIs(t, err, errClone)
+		assert.Contains(t, err.Error(), repoURLs[1])
+		assert.Len(t, modules, 2)
+
+		close(events)
+
+		var actual []module.RepoEvent
+
+		for event := range events {
+			actual = append(actual, event)
+		}
+
+		require.Len(t, actual, 6)
+
+		for i, expected := range []module.RepoEvent{
+			{RepoURL: repoURLs[0], Status: module.RepoStatusCloning},
+			{RepoURL: repoURLs[0], Status: module.RepoStatusDone},
+			{RepoURL: repoURLs[1], Status: module.RepoStatusCloning},
+			{RepoURL: repoURLs[1], Status: module.RepoStatusFailed},
+			{RepoURL: repoURLs[2], Status: module.RepoStatusCloning},
+			{RepoURL: repoURLs[2], Status: module.RepoStatusDone},
+		} {
+			assert.Equal(t, expected.RepoURL, actual[i].RepoURL)
+			assert.Equal(t, expected.Status, actual[i].Status)
+		}
+
+		assert.ErrorIs(t, actual[3].Err, errClone)
+	})
+}
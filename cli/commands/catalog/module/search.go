@@ -0,0 +1,161 @@
+package module
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/agext/levenshtein"
+)
+
+// The weights of the module fields in the search score, so that a title match ranks above a description match,
+// and a description match ranks above a path match.
+const (
+	searchTitleWeight       = 3
+	searchDescriptionWeight = 2
+	searchPathWeight        = 1
+)
+
+// The scores of a single search term matching a field, from the best to the worst match.
+const (
+	searchExactScore      = 1.0
+	searchWordPrefixScore = 0.9
+	searchSubstringScore  = 0.8
+	searchTypoScore       = 0.6
+	searchTypoPenalty     = 0.1
+	searchSubseqScore     = 0.3
+)
+
+// searchTypoLenRatio is the ratio of the term length to the number of tolerated typos, e.g. 2 typos in `kubernets`.
+const searchTypoLenRatio = 3
+
+type searchField struct {
+	text        string
+	weight      float64
+	allowSubseq bool
+}
+
+// Search returns the modules matching the given `query`, sorted by relevance, with ties broken by the module dir.
+// Each of the whitespace-separated terms of the query must match the title, description, or path of the module. The match
+// is case-insensitive and tolerates typos: a term matches a word within the edit distance of a third of the term length,
+// and the title and path also match if they contain the term letters in order, e.g. `albic` matches `alb-ingress-controller`.
+// If the query is empty, all the modules are returned in the original order.
+func (modules Modules) Search(query string) Modules {
+	terms := strings.Fields(strings.ToLower(query))
+	if len(terms) == 0 {
+		return slices.Clone(modules)
+	}
+
+	type scoredModule struct {
+		module *Module
+		score  float64
+	}
+
+	var scored []scoredModule
+
+	for _, module := range modules {
+		if score := module.searchScore(terms); score > 0 {
+			scored = append(scored, scoredModule{module: module, score: score})
+		}
+	}
+
+	slices.SortStableFunc(scored, func(a, b scoredModule) int {
+		if a.score != b.score {
+			if a.score > b.score {
+				return -1
+			}
+
+			return 1
+		}
+
+		return strings.Compare(a.module.ModuleDir(), b.module.ModuleDir())
+	})
+
+	found := make(Modules, 0, len(scored))
+
+	for _, item := range scored {
+		found = append(found, item.module)
+	}
+
+	return found
+}
+
+// searchScore returns the sum of the best weighted scores of the given terms, or zero if any of the terms does not match.
+func (module *Module) searchScore(terms []string) float64 {
+	fields := []searchField{
+		{text: module.Title(), weight: searchTitleWeight, allowSubseq: true},
+		{text: filepath.ToSlash(module.ModuleDir()), weight: searchPathWeight, allowSubseq: true},
+	}
+
+	if desc := module.Description(); desc != defaultDescription {
+		fields = append(fields, searchField{text: desc, weight: searchDescriptionWeight})
+	}
+
+	var total float64
+
+	for _, term := range terms {
+		var best float64
+
+		for _, field := range fields {
+			best = max(best, field.weight*matchScore(term, strings.ToLower(field.text), field.allowSubseq))
+		}
+
+		if best == 0 {
+			return 0
+		}
+
+		total += best
+	}
+
+	return total
+}
+
+// matchScore returns how well the lowercase `term` matches the lowercase `text`, zero if it does not match.
+func matchScore(term, text string, allowSubseq bool) float64 {
+	if text == term {
+		return searchExactScore
+	}
+
+	if idx := strings.Index(text, term); idx >= 0 {
+		if idx == 0 || !isSearchWordRune(rune(text[idx-1])) {
+			return searchWordPrefixScore
+		}
+
+		return searchSubstringScore
+	}
+
+	var score float64
+
+	if maxTypos := len(term) / searchTypoLenRatio; maxTypos > 0 {
+		for _, word := range strings.FieldsFunc(text, func(r rune) bool { return !isSearchWordRune(r) }) {
+			if distance := levenshtein.Distance(term, word, nil); distance <= maxTypos {
+				score = max(score, searchTypoScore-searchTypoPenalty*float64(distance))
+			}
+		}
+	}
+
+	if score == 0 && allowSubseq && isSubsequence(term, text) {
+		score = searchSubseqScore
+	}
+
+	return score
+}
+
+// isSubsequence returns true if `text` contains all the runes of `term` in the same order.
+func isSubsequence(term, text string) bool {
+	termRunes := []rune(term)
+	pos := 0
+
+	for _, r := range text {
+		if pos < len(termRunes) && r == termRunes[pos] {
+			pos++
+		}
+	}
+
+	return pos == len(termRunes)
+}
+
+func isSearchWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
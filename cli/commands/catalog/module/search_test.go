@@ -0,0 +1,119 @@
+package module_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModulesSearch(t *testing.T) {
+	t.Parallel()
+
+	repoPath := t.TempDir()
+
+	readmes := map[string]string{
+		"modules/eks-cluster":      "# Kubernetes Cluster\n\nDeploys an EKS cluster.\n",
+		"modules/network-firewall": "# Firewall\n\nCreates an AWS Network Firewall.\n",
+		"modules/vpc":              "# Network\n\nCreates a VPC with public and private subnets.\n",
+		"modules/s3-bucket":        "# S3 Bucket\n\nCreates an S3 bucket.\n",
+	}
+
+	for moduleDir, readme := range readmes {
+		modulePath := filepath.Join(repoPath, moduleDir)
+
+		require.NoError(t, os.MkdirAll(modulePath, os.ModePerm))
+		require.NoError(t, os.WriteFile(filepath.Join(modulePath, "main.tf"), []byte{}, 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(modulePath, "README.md"), []byte(readme), 0644))
+	}
+
+	modules, err := newLocalRepo(t, repoPath).FindModules(context.Background())
+	require.NoError(t, err)
+	require.Len(t, modules, 4)
+
+	testCases := []struct {
+		name         string
+		query        string
+		expectedDirs []string
+	}{
+		{
+			name:         "empty query",
+			query:        "  ",
+			expectedDirs: []string{"modules/eks-cluster", "modules/network-firewall", "modules/s3-bucket", "modules/vpc"},
+		},
+		{
+			name:         "typo",
+			query:        "kuberntes",
+			expectedDirs: []string{"modules/eks-cluster"},
+		},
+		{
+			name:         "case-insensitive",
+			query:        "BUCKET",
+			expectedDirs: []string{"modules/s3-bucket"},
+		},
+		{
+			name:         "title above description and path",
+			query:        "network",
+			expectedDirs: []string{"modules/vpc", "modules/network-firewall"},
+		},
+		{
+			name:         "all terms must match",
+			query:        "network firewal",
+			expectedDirs: []string{"modules/network-firewall"},
+		},
+		{
+			name:         "subsequence of path",
+			query:        "ekscl",
+			expectedDirs: []string{"modules/eks-cluster"},
+		},
+		{
+			name:  "gibberish",
+			query: "qzxjw",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var dirs []string
+
+			for _, mod := range modules.Search(tc.query) {
+				dirs = append(dirs, mod.ModuleDir())
+			}
+
+			assert.Equal(t, tc.expectedDirs, dirs)
+		})
+	}
+}
+
+func TestModulesSearchTitleAbovePath(t *testing.T) {
+	t.Parallel()
+
+	repoPath := t.TempDir()
+
+	readmes := map[string]string{
+		"modules/lb":           "# Load Balancer\n",
+		"modules/loadbalancer": "# Ingress\n",
+	}
+
+	for moduleDir, readme := range readmes {
+		modulePath := filepath.Join(repoPath, moduleDir)
+
+		require.NoError(t, os.MkdirAll(modulePath, os.ModePerm))
+		require.NoError(t, os.WriteFile(filepath.Join(modulePath, "main.tf"), []byte{}, 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(modulePath, "README.md"), []byte(readme), 0644))
+	}
+
+	modules, err := newLocalRepo(t, repoPath).FindModules(context.Background())
+	require.NoError(t, err)
+
+	found := modules.Search("load")
+	require.Len(t, found, 2)
+
+	assert.Equal(t, "modules/lb", found[0].ModuleDir())
+	assert.Equal(t, "modules/loadbalancer", found[1].ModuleDir())
+}
@@ -0,0 +1,38 @@
+package module
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/gruntwork-io/terragrunt/cli/commands/catalog/module"
+
+// Span names and attributes of the catalog operations.
+const (
+	SpanNameNewRepo     = "catalog_new_repo"
+	SpanNameClone       = "catalog_clone"
+	SpanNameFindModules = "catalog_find_modules"
+
+	SpanAttrRepoURL     = "repo_url"
+	SpanAttrCloneSource = "clone_source"
+	SpanAttrModuleCount = "module_count"
+)
+
+// startSpan starts a span with the tracer provider of the span in `ctx`. With telemetry enabled, the catalog spans are nested
+// in the command span, otherwise `ctx` has no span and the returned span is a no-op.
+func startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return trace.SpanFromContext(ctx).TracerProvider().Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// endSpan records the error, if any, and ends the span.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
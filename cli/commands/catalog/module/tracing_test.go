@@ -0,0 +1,82 @@
+package module_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/cli/commands/catalog/module"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestRepoSpans(t *testing.T) {
+	t.Parallel()
+
+	const cloneURL = "https://github.com/acme/terraform-aws-modules.git"
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	// The catalog spans use the tracer of the span in the context, e.g. the command span.
+	ctx, commandSpan := provider.Tracer("test").Start(context.Background(), "catalog")
+
+	fakeGetter := func(_ context.Context, dst, _ string) error {
+		if err := os.MkdirAll(filepath.Join(dst, "modules", "vpc"), os.ModePerm); err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(filepath.Join(dst, "modules", "vpc", "main.tf"), []byte{}, 0644); err != nil {
+			return err
+		}
+
+		return writeGitDir(t, dst, cloneURL)
+	}
+
+	repo, err := module.NewRepo(ctx, log.New(), cloneURL, t.TempDir(), false, module.WithGetter(fakeGetter))
+	require.NoError(t, err)
+
+	_, err = repo.FindModules(ctx)
+	require.NoError(t, err)
+
+	_, err = module.NewRepo(ctx, log.New(), cloneURL, t.TempDir(), false,
+		module.WithGetter(func(context.Context, string, string) error { return errors.New("repository not found") }),
+		module.WithCloneRetry(1, 0),
+	)
+	require.Error(t, err)
+
+	commandSpan.End()
+
+	spans := make(map[string][]sdktrace.ReadOnlySpan)
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = append(spans[span.Name()], span)
+	}
+
+	require.Len(t, spans[module.SpanNameNewRepo], 2)
+	require.Len(t, spans[module.SpanNameClone], 2)
+	require.Len(t, spans[module.SpanNameFindModules], 1)
+
+	newRepoSpan := spans[module.SpanNameNewRepo][0]
+	assert.Equal(t, commandSpan.SpanContext().SpanID(), newRepoSpan.Parent().SpanID())
+	assert.Contains(t, newRepoSpan.Attributes(), attribute.String(module.SpanAttrRepoURL, cloneURL))
+	assert.Contains(t, newRepoSpan.Attributes(), attribute.String(module.SpanAttrCloneSource, string(module.CloneSourceGetter)))
+
+	cloneSpan := spans[module.SpanNameClone][0]
+	assert.Equal(t, newRepoSpan.SpanContext().SpanID(), cloneSpan.Parent().SpanID())
+	assert.Contains(t, cloneSpan.Attributes(), attribute.String(module.SpanAttrCloneSource, string(module.CloneSourceGetter)))
+	assert.Positive(t, cloneSpan.EndTime().Sub(cloneSpan.StartTime()))
+
+	assert.Contains(t, spans[module.SpanNameFindModules][0].Attributes(), attribute.Int(module.SpanAttrModuleCount, 1))
+
+	for _, span := range []sdktrace.ReadOnlySpan{spans[module.SpanNameNewRepo][1], spans[module.SpanNameClone][1]} {
+		assert.Equal(t, codes.Error, span.Status().Code, span.Name())
+		assert.NotEmpty(t, span.Events(), "the error is recorded in %s", span.Name())
+	}
+}
@@ -0,0 +1,176 @@
+package module
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gruntwork-io/go-commons/files"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/tf"
+	"gopkg.in/ini.v1"
+)
+
+const (
+	gitURLSectionPrefix = "url "
+
+	// The keys are lowercased, since git config keys are case-insensitive.
+	gitInsteadOfKey     = "insteadof"
+	gitPushInsteadOfKey = "pushinsteadof"
+
+	gitSystemConfigPath = "/etc/gitconfig"
+)
+
+// URLRewriteRules are the `url.<base>.insteadOf` and `url.<base>.pushInsteadOf` rules of git config, which redirect
+// the repository URLs, e.g. to an internal mirror.
+type URLRewriteRules struct {
+	// insteadOf and pushInsteadOf map the URL prefixes to be replaced to their replacements, the `<base>` of the rules.
+	insteadOf     map[string]string
+	pushInsteadOf map[string]string
+}
+
+// DefaultGitConfigPaths returns the paths of the system and global git config files, in the order git reads them.
+// As git does, `GIT_CONFIG_SYSTEM`, `GIT_CONFIG_GLOBAL` and `GIT_CONFIG_NOSYSTEM` env vars are honored.
+func DefaultGitConfigPaths() []string {
+	var paths []string
+
+	if noSystem := os.Getenv("GIT_CONFIG_NOSYSTEM"); noSystem == "" || noSystem == "0" || strings.EqualFold(noSystem, "false") {
+		if path := os.Getenv("GIT_CONFIG_SYSTEM"); path != "" {
+			paths = append(paths, path)
+		} else {
+			paths = append(paths, gitSystemConfigPath)
+		}
+	}
+
+	if path := os.Getenv("GIT_CONFIG_GLOBAL"); path != "" {
+		return append(paths, path)
+	}
+
+	configHome := os.Getenv("XDG_CONFIG_HOME")
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		homeDir = ""
+	}
+
+	if configHome == "" && homeDir != "" {
+		configHome = filepath.Join(homeDir, ".config")
+	}
+
+	if configHome != "" {
+		paths = append(paths, filepath.Join(configHome, "git", "config"))
+	}
+
+	if homeDir != "" {
+		paths = append(paths, filepath.Join(homeDir, ".gitconfig"))
+	}
+
+	return paths
+}
+
+// LoadURLRewriteRules loads the URL rewrite rules from the given git config files. Files that do not exist are skipped.
+// The `include` and `includeIf` directives are not followed.
+func LoadURLRewriteRules(paths ...string) (*URLRewriteRules, error) {
+	rules := &URLRewriteRules{
+		insteadOf:     make(map[string]string),
+		pushInsteadOf: make(map[string]string),
+	}
+
+	for _, path := range paths {
+		if !files.FileExists(path) {
+			continue
+		}
+
+		inidata, err := ini.LoadSources(ini.LoadOptions{AllowShadows: true, InsensitiveKeys: true}, path)
+		if err != nil {
+			return nil, errors.Errorf("failed to load git config %q: %w", path, err)
+		}
+
+		for _, section := range inidata.Sections() {
+			base, ok := strings.CutPrefix(section.Name(), gitURLSectionPrefix)
+			if !ok {
+				continue
+			}
+
+			base = strings.Trim(strings.TrimSpace(base), `"`)
+
+			for key, prefixes := range map[string]map[string]string{gitInsteadOfKey: rules.insteadOf, gitPushInsteadOfKey: rules.pushInsteadOf} {
+				if !section.HasKey(key) {
+					continue
+				}
+
+				for _, prefix := range section.Key(key).ValueWithShadows() {
+					if prefix != "" {
+						prefixes[prefix] = base
+					}
+				}
+			}
+		}
+	}
+
+	return rules, nil
+}
+
+// Rewrite returns the given URL rewritten by the `insteadOf` rule with the longest matching prefix, as git does when fetching.
+// The URL is returned unchanged if no rule matches.
+func (rules *URLRewriteRules) Rewrite(rawURL string) string {
+	rewritten, _ := rewriteURL(rules.insteadOf, rawURL)
+
+	return rewritten
+}
+
+// RewritePush returns the given URL rewritten by the `pushInsteadOf` rule with the longest matching prefix, as git does when pushing.
+// If no `pushInsteadOf` rule matches, the `insteadOf` rules are applied.
+func (rules *URLRewriteRules) RewritePush(rawURL string) string {
+	if rewritten, ok := rewriteURL(rules.pushInsteadOf, rawURL); ok {
+		return rewritten
+	}
+
+	return rules.Rewrite(rawURL)
+}
+
+// rewriteSourceURL applies the rewrite rules to the given source URL, keeping the forced getter, e.g. `git::`.
+// If the rules are not set, they are loaded from `DefaultGitConfigPaths`.
+func (repo *Repo) rewriteSourceURL(sourceURL *url.URL) (*url.URL, error) {
+	if repo.urlRewriteRules == nil {
+		rules, err := LoadURLRewriteRules(DefaultGitConfigPaths()...)
+		if err != nil {
+			return nil, err
+		}
+
+		repo.urlRewriteRules = rules
+	}
+
+	forcedGetter, rawURL, ok := strings.Cut(sourceURL.String(), "::")
+	if !ok {
+		forcedGetter, rawURL = "", forcedGetter
+	} else {
+		forcedGetter += "::"
+	}
+
+	rewrittenURL := repo.urlRewriteRules.Rewrite(rawURL)
+	if rewrittenURL == rawURL {
+		return sourceURL, nil
+	}
+
+	repo.logger.Debugf("Rewriting clone URL %q to %q according to the git config insteadOf rules", rawURL, rewrittenURL)
+
+	return tf.ToSourceURL(forcedGetter+rewrittenURL, "")
+}
+
+func rewriteURL(prefixes map[string]string, rawURL string) (string, bool) {
+	var longestPrefix string
+
+	for prefix := range prefixes {
+		if strings.HasPrefix(rawURL, prefix) && len(prefix) > len(longestPrefix) {
+			longestPrefix = prefix
+		}
+	}
+
+	if longestPrefix == "" {
+		return rawURL, false
+	}
+
+	return prefixes[longestPrefix] + strings.TrimPrefix(rawURL, longestPrefix), true
+}
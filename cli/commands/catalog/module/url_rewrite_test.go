@@ -0,0 +1,108 @@
+package module_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/cli/commands/catalog/module"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestURLRewriteRules(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+
+	systemConfig := filepath.Join(tempDir, "gitconfig")
+	require.NoError(t, os.WriteFile(systemConfig, []byte(`[url "https://mirror.acme.internal/github/"]
+	insteadOf = https://github.com/
+	insteadOf = git@github.com:
+`), 0644))
+
+	globalConfig := filepath.Join(tempDir, ".gitconfig")
+	require.NoError(t, os.WriteFile(globalConfig, []byte(`[user]
+	name = Jane Doe
+[url "https://mirror.acme.internal/platform/"]
+	InsteadOf = https://github.com/acme-platform/
+[url "ssh://git@push.acme.internal/github/"]
+	pushInsteadOf = https://github.com/
+`), 0644))
+
+	rules, err := module.LoadURLRewriteRules(systemConfig, filepath.Join(tempDir, "missing"), globalConfig)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		url          string
+		expected     string
+		expectedPush string
+	}{
+		{
+			url:          "https://github.com/gruntwork-io/terraform-aws-eks.git",
+			expected:     "https://mirror.acme.internal/github/gruntwork-io/terraform-aws-eks.git",
+			expectedPush: "ssh://git@push.acme.internal/github/gruntwork-io/terraform-aws-eks.git",
+		},
+		{
+			url:          "https://github.com/acme-platform/terraform-modules.git",
+			expected:     "https://mirror.acme.internal/platform/terraform-modules.git",
+			expectedPush: "ssh://git@push.acme.internal/github/acme-platform/terraform-modules.git",
+		},
+		{
+			url:          "git@github.com:gruntwork-io/terraform-aws-eks.git",
+			expected:     "https://mirror.acme.internal/github/gruntwork-io/terraform-aws-eks.git",
+			expectedPush: "https://mirror.acme.internal/github/gruntwork-io/terraform-aws-eks.git",
+		},
+		{
+			url:          "https://gitlab.com/acme/terraform-modules.git",
+			expected:     "https://gitlab.com/acme/terraform-modules.git",
+			expectedPush: "https://gitlab.com/acme/terraform-modules.git",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.url, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, rules.Rewrite(tc.url))
+			assert.Equal(t, tc.expectedPush, rules.RewritePush(tc.url))
+		})
+	}
+}
+
+func TestNewRepoURLRewrite(t *testing.T) {
+	t.Parallel()
+
+	gitConfig := filepath.Join(t.TempDir(), ".gitconfig")
+	require.NoError(t, os.WriteFile(gitConfig, []byte(`[url "https://mirror.acme.internal/github/"]
+	insteadOf = https://github.com/
+`), 0644))
+
+	rules, err := module.LoadURLRewriteRules(gitConfig)
+	require.NoError(t, err)
+
+	var clonedSrc string
+
+	getter := func(_ context.Context, dst, src string) error {
+		clonedSrc = src
+
+		if err := os.MkdirAll(filepath.Join(dst, "modules", "vpc"), os.ModePerm); err != nil {
+			return err
+		}
+
+		remoteURL, _, _ := strings.Cut(strings.TrimPrefix(src, "git::"), "?")
+
+		return writeGitDir(t, dst, remoteURL)
+	}
+
+	repo, err := module.NewRepo(context.Background(), log.New(), "https://github.com/acme/terraform-aws-modules.git", t.TempDir(), false,
+		module.WithGetter(getter), module.WithURLRewriteRules(rules))
+	require.NoError(t, err)
+
+	t.Cleanup(func() { require.NoError(t, repo.Close()) })
+
+	assert.Equal(t, "git::https://mirror.acme.internal/github/acme/terraform-aws-modules.git?ref=HEAD", clonedSrc)
+}
@@ -43,7 +43,7 @@ func updateList(msg tea.Msg, m model) (tea.Model, tea.Cmd) {
 							return m, rendererErrCmd(err)
 						}
 
-						md, err := renderer.Render(selectedModule.Content(false))
+						md, err := renderer.Render(selectedModule.Body())
 						if err != nil {
 							return m, rendererErrCmd(err)
 						}
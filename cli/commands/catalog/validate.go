@@ -0,0 +1,43 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/shell"
+	"github.com/gruntwork-io/terragrunt/tf"
+)
+
+// RunValidate checks that the repository from the given `repoURL` is reachable, without cloning it, and prints its default branch.
+func RunValidate(ctx context.Context, opts *options.TerragruntOptions, repoURL string) error {
+	if repoURL == "" {
+		return errors.New(MissingRepoURLError{})
+	}
+
+	sourceURL, err := tf.ToSourceURL(repoURL, "")
+	if err != nil {
+		return err
+	}
+
+	// Only the repository itself is checked, the subdirectory and the query params such as `ref` are not part of the remote URL.
+	sourceURL.Path, _, _ = strings.Cut(sourceURL.Path, "//")
+	sourceURL.RawQuery = ""
+
+	defaultBranch, err := shell.GitRemoteDefaultBranch(ctx, opts, sourceURL)
+	if err != nil {
+		return errors.New(RepoNotReachableError{URL: repoURL, Err: err})
+	}
+
+	if defaultBranch == "" {
+		defaultBranch = "<none>"
+	}
+
+	if _, err := fmt.Fprintf(opts.Writer, "Repository %q is reachable, default branch: %s\n", repoURL, defaultBranch); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
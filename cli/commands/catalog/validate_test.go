@@ -0,0 +1,60 @@
+package catalog_test
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/cli/commands/catalog"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunValidate(t *testing.T) {
+	t.Parallel()
+
+	repoDir := t.TempDir()
+
+	for _, args := range [][]string{
+		{"init", "--quiet", "--initial-branch", "develop", repoDir},
+		{"-C", repoDir, "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "--allow-empty", "-m", "init"},
+	} {
+		require.NoError(t, exec.Command("git", args...).Run())
+	}
+
+	t.Run("reachable", func(t *testing.T) {
+		t.Parallel()
+
+		var stdout bytes.Buffer
+
+		opts, err := options.NewTerragruntOptionsForTest("")
+		require.NoError(t, err)
+
+		opts.Writer = &stdout
+
+		repoURL := "file://" + filepath.ToSlash(repoDir)
+
+		require.NoError(t, catalog.RunValidate(context.Background(), opts, repoURL))
+		assert.Contains(t, stdout.String(), `Repository "`+repoURL+`" is reachable, default branch: develop`)
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		t.Parallel()
+
+		opts, err := options.NewTerragruntOptionsForTest("")
+		require.NoError(t, err)
+
+		repoURL := "file://" + filepath.ToSlash(filepath.Join(t.TempDir(), "missing"))
+
+		err = catalog.RunValidate(context.Background(), opts, repoURL)
+
+		var notReachableErr catalog.RepoNotReachableError
+		require.True(t, errors.As(err, &notReachableErr), "unexpected error: %v", err)
+		assert.Equal(t, repoURL, notReachableErr.URL)
+		assert.ErrorContains(t, err, `repository "`+repoURL+`" is not reachable`)
+	})
+}
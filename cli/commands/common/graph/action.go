@@ -36,6 +36,11 @@ func Run(ctx context.Context, opts *options.TerragruntOptions) error {
 		rootDir = gitRoot
 	}
 
+	// the units are run with the clones of the options, so stdout is reserved before they are created
+	if opts.OutputJSON {
+		opts.ReserveWriterForJSON()
+	}
+
 	rootOptions, err := opts.CloneWithConfigPath(rootDir)
 	if err != nil {
 		return err
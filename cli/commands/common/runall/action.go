@@ -5,6 +5,7 @@ import (
 
 	"github.com/gruntwork-io/terragrunt/configstack"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/progress"
 	"github.com/gruntwork-io/terragrunt/options"
 	"github.com/gruntwork-io/terragrunt/shell"
 	"github.com/gruntwork-io/terragrunt/telemetry"
@@ -42,6 +43,11 @@ func Run(ctx context.Context, opts *options.TerragruntOptions) error {
 		}
 	}
 
+	// the units are run with the clones of the options, so stdout is reserved before they are created
+	if opts.OutputJSON {
+		opts.ReserveWriterForJSON()
+	}
+
 	stack, err := configstack.FindStackInSubfolders(ctx, opts)
 	if err != nil {
 		return err
@@ -53,10 +59,18 @@ func Run(ctx context.Context, opts *options.TerragruntOptions) error {
 func RunAllOnStack(ctx context.Context, opts *options.TerragruntOptions, stack *configstack.Stack) error {
 	opts.Logger.Debugf("%s", stack.String())
 
+	progress.EmitterFromContext(ctx).SetTotal(len(stack.Modules))
+
 	if err := stack.LogModuleDeployOrder(opts.Logger, opts.TerraformCommand); err != nil {
 		return err
 	}
 
+	if opts.TraceDeps {
+		if err := stack.LogDependencyTrace(opts.Logger, opts.TerraformCommand); err != nil {
+			return err
+		}
+	}
+
 	var prompt string
 
 	switch opts.TerraformCommand {
@@ -79,10 +93,19 @@ func RunAllOnStack(ctx context.Context, opts *options.TerragruntOptions, stack *
 		}
 	}
 
-	return telemetry.Telemetry(ctx, opts, "run_all_on_stack", map[string]interface{}{
+	err := telemetry.Telemetry(ctx, opts, "run_all_on_stack", map[string]interface{}{
 		"terraform_command": opts.TerraformCommand,
 		"working_dir":       opts.WorkingDir,
 	}, func(childCtx context.Context) error {
 		return stack.Run(ctx, opts)
 	})
+
+	// The results are printed even if some units failed, so that the output is always a valid JSON document.
+	if opts.OutputJSON {
+		if jsonErr := stack.WriteUnitResultsJSON(opts.ReserveWriterForJSON()); jsonErr != nil {
+			return errors.Join(err, jsonErr)
+		}
+	}
+
+	return err
 }
@@ -1,12 +1,22 @@
 package runall_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
 
 	"github.com/gruntwork-io/terragrunt/cli/commands/common/runall"
+	"github.com/gruntwork-io/terragrunt/cli/commands/run"
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/configstack"
+	"github.com/gruntwork-io/terragrunt/internal/progress"
 	"github.com/gruntwork-io/terragrunt/options"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -28,3 +38,97 @@ func TestMissingRunAllArguments(t *testing.T) {
 	fmt.Println(err, errors.Unwrap(err))
 	assert.True(t, ok)
 }
+
+// syncBuffer is a buffer safe for the concurrent writes of the units.
+type syncBuffer struct {
+	buf bytes.Buffer
+	mu  sync.Mutex
+}
+
+func (buf *syncBuffer) Write(p []byte) (int, error) {
+	buf.mu.Lock()
+	defer buf.mu.Unlock()
+
+	return buf.buf.Write(p)
+}
+
+func (buf *syncBuffer) String() string {
+	buf.mu.Lock()
+	defer buf.mu.Unlock()
+
+	return buf.buf.String()
+}
+
+func TestRunAllJSONStdout(t *testing.T) {
+	t.Parallel()
+
+	// A fake binary that prints the plan summary to stdout, as OpenTofu/Terraform does.
+	tfPath := filepath.Join(t.TempDir(), "tofu")
+	require.NoError(t, os.WriteFile(tfPath, []byte(`#!/bin/sh
+case "$1" in
+  -version|version) echo 'OpenTofu v1.8.0' ;;
+  plan) echo 'Plan: 1 to add, 0 to change, 0 to destroy.' ;;
+esac
+`), 0755))
+
+	rootDir := t.TempDir()
+
+	for _, unit := range []string{"vpc", "eks"} {
+		unitDir := filepath.Join(rootDir, unit)
+
+		require.NoError(t, os.MkdirAll(filepath.Join(unitDir, ".terraform"), os.ModePerm))
+		require.NoError(t, os.WriteFile(filepath.Join(unitDir, "main.tf"), []byte{}, 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(unitDir, config.DefaultTerragruntConfigPath), []byte{}, 0644))
+	}
+
+	opts, err := options.NewTerragruntOptionsForTest(filepath.Join(rootDir, config.DefaultTerragruntConfigPath))
+	require.NoError(t, err)
+
+	var stdout, stderr syncBuffer
+
+	opts.Writer = &stdout
+	opts.ErrWriter = &stderr
+	opts.TerraformPath = tfPath
+	opts.TerraformCommand = "plan"
+	opts.TerraformCliArgs = []string{"plan"}
+	opts.OutputJSON = true
+	opts.RunTerragrunt = run.Run
+
+	// the progress events are emitted the same way as by the run command with --progress-json
+	emitter := progress.NewEmitter(opts.ReserveWriterForJSON(), "run")
+	emitter.Start(0)
+
+	err = runall.Run(progress.ContextWithEmitter(context.Background(), emitter), opts)
+	emitter.Done(err)
+	require.NoError(t, err)
+
+	assert.Contains(t, stderr.String(), "Plan: 1 to add, 0 to change, 0 to destroy.")
+	assert.NotContains(t, stdout.String(), "Plan:")
+
+	// stdout is a stream of JSON values: the progress events and the array of the unit results
+	var (
+		events  int
+		results []configstack.UnitResult
+	)
+
+	decoder := json.NewDecoder(bytes.NewBufferString(stdout.String()))
+
+	for {
+		var value json.RawMessage
+
+		if err := decoder.Decode(&value); errors.Is(err, io.EOF) {
+			break
+		} else {
+			require.NoError(t, err, stdout.String())
+		}
+
+		if value[0] == '[' {
+			require.NoError(t, json.Unmarshal(value, &results))
+		} else {
+			events++
+		}
+	}
+
+	assert.Equal(t, 2, events)
+	assert.Len(t, results, 2)
+}
@@ -16,6 +16,8 @@ const (
 	AllFlagName        = "all"
 	OutDirFlagName     = "out-dir"
 	JSONOutDirFlagName = "json-out-dir"
+	TraceDepsFlagName  = "trace-deps"
+	OutputJSONFlagName = "output-json"
 
 	DeprecatedOutDirFlagName     = "out-dir"
 	DeprecatedJSONOutDirFlagName = "json-out-dir"
@@ -56,6 +58,20 @@ func NewFlags(opts *options.TerragruntOptions, commandName string, prefix flags.
 			Usage:       "Directory to store json plan files.",
 		},
 			flags.WithDeprecatedNames(terragruntPrefix.FlagNames(DeprecatedJSONOutDirFlagName), terragruntPrefixControl)),
+
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        OutputJSONFlagName,
+			EnvVars:     tgPrefix.EnvVars(OutputJSONFlagName),
+			Destination: &opts.OutputJSON,
+			Usage:       "Print the results of the units as a JSON array to stdout once the run is finished, the output of the units goes to stderr.",
+		}),
+
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        TraceDepsFlagName,
+			EnvVars:     tgPrefix.EnvVars(TraceDepsFlagName),
+			Destination: &opts.TraceDeps,
+			Usage:       "Log the resolved dependencies of each unit and the final run order at debug level.",
+		}),
 	}
 }
 
@@ -202,7 +202,11 @@ func runTerraform(ctx context.Context, terragruntOptions *options.TerragruntOpti
 		return target.runErrorCallback(terragruntOptions, terragruntConfig, err)
 	}
 
-	if sourceURL != "" {
+	if terragruntOptions.TFWorkingDirOverride != "" && !terragruntOptions.RunAll {
+		if updatedTerragruntOptions, err = overrideWorkingDir(terragruntOptions); err != nil {
+			return target.runErrorCallback(terragruntOptions, terragruntConfig, err)
+		}
+	} else if sourceURL != "" {
 		err = telemetry.Telemetry(ctx, terragruntOptions, "download_terraform_source", map[string]interface{}{
 			"sourceUrl": sourceURL,
 		}, func(childCtx context.Context) error {
@@ -259,6 +263,30 @@ func runTerraform(ctx context.Context, terragruntOptions *options.TerragruntOpti
 	return nil
 }
 
+// overrideWorkingDir returns a copy of the options with `WorkingDir` set to `TFWorkingDirOverride`, which must be an existing directory.
+// The Terraform source is not downloaded in this case, the override directory is expected to contain the code to run.
+func overrideWorkingDir(opts *options.TerragruntOptions) (*options.TerragruntOptions, error) {
+	workingDir := opts.TFWorkingDirOverride
+	if !filepath.IsAbs(workingDir) {
+		workingDir = filepath.Join(opts.WorkingDir, workingDir)
+	}
+
+	if !util.IsDir(workingDir) {
+		return nil, errors.New(WorkingDirOverrideNotDirErr(workingDir))
+	}
+
+	opts.Logger.Debugf("Running %s in the working directory override %s instead of %s", opts.TerraformImplementation, workingDir, opts.WorkingDir)
+
+	updatedOpts, err := opts.CloneWithConfigPath(opts.TerragruntConfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedOpts.WorkingDir = workingDir
+
+	return updatedOpts, nil
+}
+
 func generateConfig(terragruntConfig *config.TerragruntConfig, updatedTerragruntOptions *options.TerragruntOptions) error {
 	rawActualLock, _ := sourceChangeLocks.LoadOrStore(updatedTerragruntOptions.DownloadDir, &sync.Mutex{})
 	actualLock := rawActualLock.(*sync.Mutex)
@@ -303,6 +331,8 @@ func runTerragruntWithConfig(ctx context.Context, originalTerragruntOptions *opt
 		}
 	}
 
+	InsertTerraformDefaultArgs(terragruntOptions)
+
 	if err := SetTerragruntInputsAsEnvVars(terragruntOptions, terragruntConfig); err != nil {
 		return err
 	}
@@ -345,9 +375,22 @@ func runTerragruntWithConfig(ctx context.Context, originalTerragruntOptions *opt
 		return err
 	}
 
+	planFile, isIntermediatePlanFile := preparePlanJSONCapture(terragruntOptions)
+	if isIntermediatePlanFile && !terragruntOptions.KeepPlanFile {
+		defer func() {
+			if err := os.Remove(planFile); err != nil && !os.IsNotExist(err) {
+				terragruntOptions.Logger.Debugf("Failed to remove plan file %s: %v", planFile, err)
+			}
+		}()
+	}
+
 	return RunActionWithHooks(ctx, "terraform", terragruntOptions, terragruntConfig, func(ctx context.Context) error {
 		runTerraformError := RunTerraformWithRetry(ctx, terragruntOptions)
 
+		if runTerraformError == nil && planFile != "" {
+			runTerraformError = capturePlanJSON(ctx, terragruntOptions, originalTerragruntOptions.WorkingDir, planFile)
+		}
+
 		var lockFileError error
 		if ShouldCopyLockFile(terragruntOptions.TerraformCliArgs, terragruntConfig.Terraform) {
 			// Copy the lock file from the Terragrunt working dir (e.g., .terragrunt-cache/xxx/<some-module>) to the
@@ -451,6 +494,98 @@ func RunActionWithHooks(ctx context.Context, description string, terragruntOptio
 	return allErrors.ErrorOrNil()
 }
 
+// InsertTerraformDefaultArgs inserts the configured default arguments of the current OpenTofu/Terraform command before the other arguments.
+// A default argument is skipped if the same flag is already present, so arguments passed by the user take precedence.
+// A default `-var` is skipped only if the user sets the same variable, and a default `-var-file` is never skipped,
+// since OpenTofu/Terraform loads all the var files.
+func InsertTerraformDefaultArgs(terragruntOptions *options.TerragruntOptions) {
+	defaultArgs := terragruntOptions.TerraformDefaultArgs[terragruntOptions.TerraformCliArgs.First()]
+	if len(defaultArgs) == 0 {
+		return
+	}
+
+	existingArgs := make(map[string]struct{}, len(terragruntOptions.TerraformCliArgs))
+
+	for _, arg := range groupArgs(terragruntOptions.TerraformCliArgs.Tail()) {
+		if key, ok := argKey(arg); ok {
+			existingArgs[key] = struct{}{}
+		}
+	}
+
+	var argsToInsert []string
+
+	for _, arg := range groupArgs(defaultArgs) {
+		if key, ok := argKey(arg); ok {
+			if _, ok := existingArgs[key]; ok {
+				terragruntOptions.Logger.Debugf("Skipping default argument %q, it is overridden by the passed arguments", strings.Join(arg, " "))
+				continue
+			}
+		}
+
+		argsToInsert = append(argsToInsert, arg...)
+	}
+
+	if len(argsToInsert) > 0 {
+		terragruntOptions.Logger.Debugf("Inserting default arguments %v into %s command", argsToInsert, terragruntOptions.TerraformCliArgs.First())
+		terragruntOptions.InsertTerraformCliArgs(argsToInsert...)
+	}
+}
+
+// groupArgs groups the `-var` and `-var-file` flags with their values passed as separate arguments, e.g. `-var region=us-east-1`,
+// the rest of the arguments are returned one per group.
+func groupArgs(args []string) [][]string {
+	groups := make([][]string, 0, len(args))
+
+	for i := 0; i < len(args); i++ {
+		if flag := "-" + argName(args[i]); (flag == tf.FlagNameVar || flag == tf.FlagNameVarFile) &&
+			!strings.Contains(args[i], "=") && i+1 < len(args) {
+			groups = append(groups, args[i:i+2])
+			i++
+
+			continue
+		}
+
+		groups = append(groups, args[i:i+1])
+	}
+
+	return groups
+}
+
+// argKey returns the key identifying the given group of arguments, see `groupArgs`, to find the default arguments overridden by the user:
+// the flag name, see `argName`, or for `-var` the flag name with the variable name, e.g. `var=region` for `-var=region=us-east-1`.
+// False is returned for `-var-file`, which is never overridden.
+func argKey(arg []string) (string, bool) {
+	name := argName(arg[0])
+
+	switch "-" + name {
+	case tf.FlagNameVarFile:
+		return "", false
+	case tf.FlagNameVar:
+		_, value, ok := strings.Cut(arg[0], "=")
+		if !ok && len(arg) > 1 {
+			value = arg[1]
+		}
+
+		varName, _, _ := strings.Cut(value, "=")
+
+		return name + "=" + varName, true
+	}
+
+	return name, true
+}
+
+// argName returns the flag name of the given argument without dashes and value, e.g. `input` for `-input=false`.
+// Non-flag arguments are returned as is.
+func argName(arg string) string {
+	if !strings.HasPrefix(arg, "-") {
+		return arg
+	}
+
+	name, _, _ := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+
+	return name
+}
+
 // SetTerragruntInputsAsEnvVars sets the inputs from Terragrunt configurations to TF_VAR_* environment variables for
 // OpenTofu/Terraform.
 func SetTerragruntInputsAsEnvVars(terragruntOptions *options.TerragruntOptions, terragruntConfig *config.TerragruntConfig) error {
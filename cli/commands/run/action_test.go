@@ -564,3 +564,134 @@ func TestShouldCopyLockFile(t *testing.T) {
 		})
 	}
 }
+
+func TestInsertTerraformDefaultArgs(t *testing.T) {
+	t.Parallel()
+
+	defaultArgs := map[string][]string{
+		"plan":  {"-input=false", "-lock-timeout=5m"},
+		"apply": {"-var=region=us-east-1", "-var", "env=dev", "-var-file=common.tfvars"},
+	}
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectedArgs []string
+	}{
+		{
+			"injected into plan",
+			[]string{"plan", "-out=tfplan"},
+			[]string{"plan", "-input=false", "-lock-timeout=5m", "-out=tfplan"},
+		},
+		{
+			"overridden by user args",
+			[]string{"plan", "-input=true"},
+			[]string{"plan", "-lock-timeout=5m", "-input=true"},
+		},
+		{
+			"not injected into other commands",
+			[]string{"destroy", "-auto-approve"},
+			[]string{"destroy", "-auto-approve"},
+		},
+		{
+			"vars injected",
+			[]string{"apply", "-auto-approve"},
+			[]string{"apply", "-var=region=us-east-1", "-var", "env=dev", "-var-file=common.tfvars", "-auto-approve"},
+		},
+		{
+			"vars overridden by name",
+			[]string{"apply", "-var", "region=eu-west-1", "-var=size=large"},
+			[]string{"apply", "-var", "env=dev", "-var-file=common.tfvars", "-var", "region=eu-west-1", "-var=size=large"},
+		},
+		{
+			"var files never overridden",
+			[]string{"apply", "-var-file=prod.tfvars", "-var=env=prod"},
+			[]string{"apply", "-var=region=us-east-1", "-var-file=common.tfvars", "-var-file=prod.tfvars", "-var=env=prod"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts, err := options.NewTerragruntOptionsForTest("")
+			require.NoError(t, err)
+
+			opts.TerraformCliArgs = testCase.args
+			opts.TerraformDefaultArgs = defaultArgs
+
+			run.InsertTerraformDefaultArgs(opts)
+
+			assert.Equal(t, testCase.expectedArgs, []string(opts.TerraformCliArgs))
+		})
+	}
+}
+
+func TestRunWorkingDirOverride(t *testing.T) {
+	t.Parallel()
+
+	// A fake binary, so that the version check does not require OpenTofu to be installed.
+	tfPath := filepath.Join(t.TempDir(), "tofu")
+	require.NoError(t, os.WriteFile(tfPath, []byte("#!/bin/sh\necho 'OpenTofu v1.8.0'\n"), 0755))
+
+	newOpts := func(t *testing.T, override string) *options.TerragruntOptions {
+		t.Helper()
+
+		unitDir := t.TempDir()
+		configPath := filepath.Join(unitDir, config.DefaultTerragruntConfigPath)
+		require.NoError(t, os.WriteFile(configPath, []byte(`terraform { source = "./does-not-exist" }`), 0644))
+
+		opts, err := options.NewTerragruntOptionsForTest(configPath)
+		require.NoError(t, err)
+
+		opts.TerraformCommand = "plan"
+		opts.TerraformPath = tfPath
+		opts.TFWorkingDirOverride = override
+
+		return opts
+	}
+
+	t.Run("override is used", func(t *testing.T) {
+		t.Parallel()
+
+		overrideDir := t.TempDir()
+		opts := newOpts(t, overrideDir)
+
+		var workingDir string
+
+		target := run.NewTarget(run.TargetPointDownloadSource, func(_ context.Context, opts *options.TerragruntOptions, _ *config.TerragruntConfig) error {
+			workingDir = opts.WorkingDir
+			return nil
+		})
+
+		require.NoError(t, run.RunWithTarget(context.Background(), opts, target))
+		assert.Equal(t, overrideDir, workingDir)
+	})
+
+	t.Run("override does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		overrideDir := filepath.Join(t.TempDir(), "missing")
+		opts := newOpts(t, overrideDir)
+
+		err := run.RunWithTarget(context.Background(), opts, run.NewTarget(run.TargetPointDownloadSource, func(context.Context, *options.TerragruntOptions, *config.TerragruntConfig) error {
+			return nil
+		}))
+
+		var notDirErr run.WorkingDirOverrideNotDirErr
+		require.ErrorAs(t, err, &notDirErr)
+		assert.Equal(t, overrideDir, string(notDirErr))
+	})
+
+	t.Run("options of other units do not inherit the override", func(t *testing.T) {
+		t.Parallel()
+
+		opts := newOpts(t, t.TempDir())
+
+		unitOpts, err := opts.CloneWithConfigPath(filepath.Join(t.TempDir(), config.DefaultTerragruntConfigPath))
+		require.NoError(t, err)
+		assert.Empty(t, unitOpts.TFWorkingDirOverride)
+	})
+}
@@ -7,9 +7,11 @@ import (
 	"github.com/gruntwork-io/go-commons/collections"
 	"github.com/gruntwork-io/terragrunt/cli/commands/common/graph"
 	"github.com/gruntwork-io/terragrunt/cli/commands/common/runall"
+	"github.com/gruntwork-io/terragrunt/cli/flags"
 	"github.com/gruntwork-io/terragrunt/internal/cli"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/gruntwork-io/terragrunt/internal/experiment"
+	"github.com/gruntwork-io/terragrunt/internal/progress"
 	"github.com/gruntwork-io/terragrunt/options"
 	"github.com/gruntwork-io/terragrunt/tf"
 )
@@ -31,7 +33,7 @@ func NewCommand(opts *options.TerragruntOptions) *cli.Command {
 			//
 			// "# Run a plan against a Stack of configurations in the current directory\nterragrunt run --all -- plan",
 		},
-		Flags:                NewFlags(opts, nil),
+		Flags:                append(NewFlags(opts, nil), flags.NewProgressJSONFlag(opts, nil)).Sort(),
 		ErrorOnUndefinedFlag: true,
 		Subcommands:          NewSubcommands(opts),
 		Before: func(ctx *cli.Context) error {
@@ -52,10 +54,32 @@ func NewCommand(opts *options.TerragruntOptions) *cli.Command {
 
 	cmd = runall.WrapCommand(opts, cmd)
 	cmd = graph.WrapCommand(opts, cmd)
+	cmd = wrapWithProgress(opts, cmd)
 
 	return cmd
 }
 
+// wrapWithProgress wraps the action of the given `cmd` to emit the start and done progress events,
+// the emitter is passed through the context so that each unit run can report its progress.
+func wrapWithProgress(opts *options.TerragruntOptions, cmd *cli.Command) *cli.Command {
+	return cmd.WrapAction(func(ctx *cli.Context, action cli.ActionFunc) error {
+		if !opts.ProgressJSON {
+			return action(ctx)
+		}
+
+		emitter := progress.NewEmitter(opts.ReserveWriterForJSON(), CommandName)
+		emitter.Start(0)
+
+		progressCtx := *ctx
+		progressCtx.Context = progress.ContextWithEmitter(ctx.Context, emitter)
+
+		err := action(&progressCtx)
+		emitter.Done(err)
+
+		return err
+	})
+}
+
 func NewSubcommands(opts *options.TerragruntOptions) cli.Commands {
 	var subcommands = make(cli.Commands, len(tf.CommandNames))
 
@@ -88,12 +112,28 @@ func Action(opts *options.TerragruntOptions) cli.ActionFunc {
 			return err
 		}
 
-		return Run(ctx.Context, opts.OptionsFromContext(ctx))
+		// the options from the context may override the tofu/terraform binary, e.g. the one set in the unit config
+		opts = opts.OptionsFromContext(ctx)
+
+		if opts.Preflight {
+			tfPath, err := Preflight(ctx.Context, opts)
+			if err != nil {
+				return err
+			}
+
+			opts.Logger.Debugf("Preflight check of %s %s passed", tfPath, opts.TerraformVersion)
+		}
+
+		defer progress.EmitterFromContext(ctx).Progress(opts.WorkingDir)
+
+		return Run(ctx.Context, opts)
 	}
 }
 
 func validateCommand(opts *options.TerragruntOptions) error {
-	if opts.DisableCommandValidation || collections.ListContainsElement(tf.CommandNames, opts.TerraformCommand) {
+	if opts.DisableCommandValidation || opts.PassthroughAll ||
+		collections.ListContainsElement(tf.CommandNames, opts.TerraformCommand) ||
+		collections.ListContainsElement(opts.AllowedCommands, opts.TerraformCommand) {
 		return nil
 	}
 
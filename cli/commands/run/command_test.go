@@ -2,6 +2,7 @@ package run_test
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 
 	"github.com/gruntwork-io/terragrunt/cli/commands/run"
@@ -56,3 +57,98 @@ func TestAction(t *testing.T) {
 		})
 	}
 }
+
+func TestActionAllowCommand(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name        string
+		command     string
+		expectedErr error
+	}{
+		{
+			name:    "allowed command",
+			command: "foo",
+		},
+		{
+			name:        "unlisted command",
+			command:     "bar",
+			expectedErr: run.WrongTofuCommand("bar"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts, err := options.NewTerragruntOptionsForTest(filepath.Join(t.TempDir(), "terragrunt.hcl"))
+			require.NoError(t, err)
+
+			opts.TerraformCommand = tc.command
+			opts.TerraformPath = "tofu"
+			opts.AllowedCommands = []string{"foo"}
+
+			ctx := cli.NewAppContext(context.Background(), cli.NewApp(), nil).
+				NewCommandContext(run.NewCommand(opts), []string{tc.command})
+
+			err = run.Action(opts)(ctx)
+			require.Error(t, err)
+
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+
+				return
+			}
+
+			// the command passes the validation and fails later, since there is no configuration
+			require.NotErrorIs(t, err, run.WrongTofuCommand(tc.command))
+		})
+	}
+}
+
+func TestActionPassthroughAll(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name           string
+		passthroughAll bool
+		expectedErr    error
+	}{
+		{
+			name:           "passthrough all",
+			passthroughAll: true,
+		},
+		{
+			name:        "validated",
+			expectedErr: run.WrongTofuCommand("experimental-cmd"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts, err := options.NewTerragruntOptionsForTest(filepath.Join(t.TempDir(), "terragrunt.hcl"))
+			require.NoError(t, err)
+
+			opts.TerraformCommand = "experimental-cmd"
+			opts.TerraformPath = "tofu"
+			opts.PassthroughAll = tc.passthroughAll
+
+			ctx := cli.NewAppContext(context.Background(), cli.NewApp(), nil).
+				NewCommandContext(run.NewCommand(opts), []string{"experimental-cmd"})
+
+			err = run.Action(opts)(ctx)
+			require.Error(t, err)
+
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+
+				return
+			}
+
+			// the command passes the validation and fails later, since there is no configuration
+			require.NotErrorIs(t, err, run.WrongTofuCommand("experimental-cmd"))
+		})
+	}
+}
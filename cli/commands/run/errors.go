@@ -4,9 +4,14 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/agext/levenshtein"
 	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/tf"
 )
 
+// maxCommandSuggestionDistance is the maximum Levenshtein distance between a mistyped command and a known one to suggest the latter.
+const maxCommandSuggestionDistance = 2
+
 // Custom error types
 
 type MissingCommand struct{}
@@ -18,13 +23,45 @@ func (err MissingCommand) Error() string {
 type WrongTerraformCommand string
 
 func (name WrongTerraformCommand) Error() string {
-	return fmt.Sprintf("Terraform has no command named %q. To see all of Terraform's top-level commands, run: terraform -help", string(name))
+	return fmt.Sprintf("Terraform has no command named %q.%s To see all of Terraform's top-level commands, run: terraform -help", string(name), commandSuggestion(string(name)))
 }
 
 type WrongTofuCommand string
 
 func (name WrongTofuCommand) Error() string {
-	return fmt.Sprintf("OpenTofu has no command named %q. To see all of OpenTofu's top-level commands, run: tofu -help", string(name))
+	return fmt.Sprintf("OpenTofu has no command named %q.%s To see all of OpenTofu's top-level commands, run: tofu -help", string(name), commandSuggestion(string(name)))
+}
+
+// commandSuggestion returns the " Did you mean `<command>`?" hint with the known command closest to the given name,
+// or an empty string if none of the commands is close enough.
+func commandSuggestion(name string) string {
+	if command, ok := closestCommand(name); ok {
+		return fmt.Sprintf(" Did you mean `%s`?", command)
+	}
+
+	return ""
+}
+
+// closestCommand returns the command from `tf.CommandNames` with the smallest Levenshtein distance to the given name.
+// A command is only considered close if the distance is at most `maxCommandSuggestionDistance` and at most half the name length,
+// so that short or unrelated names do not get a suggestion.
+func closestCommand(name string) (string, bool) {
+	var (
+		closest     string
+		minDistance = maxCommandSuggestionDistance + 1
+	)
+
+	for _, command := range tf.CommandNames {
+		if distance := levenshtein.Distance(name, command, nil); distance < minDistance {
+			closest, minDistance = command, distance
+		}
+	}
+
+	if closest == "" || minDistance*2 > len(name) {
+		return "", false
+	}
+
+	return closest, true
 }
 
 type BackendNotDefined struct {
@@ -66,3 +103,22 @@ type RunAllDisabledErr struct {
 func (err RunAllDisabledErr) Error() string {
 	return fmt.Sprintf("%s with run-all is disabled: %s", err.command, err.reason)
 }
+
+type WorkingDirOverrideNotDirErr string
+
+func (path WorkingDirOverrideNotDirErr) Error() string {
+	return fmt.Sprintf("The working directory override %s does not exist or is not a directory", string(path))
+}
+
+type TerraformBinaryNotFoundErr struct {
+	Err  error
+	Path string
+}
+
+func (err TerraformBinaryNotFoundErr) Error() string {
+	return fmt.Sprintf("The OpenTofu/Terraform binary %s was not found or is not executable: %v", err.Path, err.Err)
+}
+
+func (err TerraformBinaryNotFoundErr) Unwrap() error {
+	return err.Err
+}
@@ -0,0 +1,57 @@
+package run_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/cli/commands/run"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrongCommandSuggestion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		command            string
+		expectedSuggestion string
+	}{
+		{
+			name:               "transposed letters",
+			command:            "plna",
+			expectedSuggestion: "Did you mean `plan`?",
+		},
+		{
+			name:               "missing letter",
+			command:            "aply",
+			expectedSuggestion: "Did you mean `apply`?",
+		},
+		{
+			name:               "extra letter",
+			command:            "destroyy",
+			expectedSuggestion: "Did you mean `destroy`?",
+		},
+		{
+			name:    "gibberish",
+			command: "xyzzyqwerty",
+		},
+		{
+			name:    "short name",
+			command: "foo",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, err := range []error{run.WrongTofuCommand(tc.command), run.WrongTerraformCommand(tc.command)} {
+				if tc.expectedSuggestion == "" {
+					assert.NotContains(t, err.Error(), "Did you mean")
+					continue
+				}
+
+				assert.Contains(t, err.Error(), tc.expectedSuggestion)
+			}
+		})
+	}
+}
@@ -2,10 +2,15 @@
 package run
 
 import (
+	"slices"
 	"strconv"
+	"strings"
 
+	"github.com/google/shlex"
 	"github.com/gruntwork-io/terragrunt/cli/flags"
 	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/os/exec"
 	"github.com/gruntwork-io/terragrunt/internal/strict/controls"
 	"github.com/gruntwork-io/terragrunt/options"
 	"github.com/gruntwork-io/terragrunt/util"
@@ -19,6 +24,10 @@ const (
 	DownloadDirFlagName                    = "download-dir"
 	TFForwardStdoutFlagName                = "tf-forward-stdout"
 	TFPathFlagName                         = "tf-path"
+	TFDefaultArgFlagName                   = "tf-default-arg"
+	TFWrapperFlagName                      = "tf-wrapper"
+	InterruptModeFlagName                  = "interrupt-mode"
+	KillTimeoutFlagName                    = "kill-timeout"
 	FeatureFlagName                        = "feature"
 	ParallelismFlagName                    = "parallelism"
 	InputsDebugFlagName                    = "inputs-debug"
@@ -30,8 +39,14 @@ const (
 	DisableBucketUpdateFlagName     = "disable-bucket-update"
 
 	DisableCommandValidationFlagName   = "disable-command-validation"
+	AllowCommandFlagName               = "allow-command"
+	PassthroughAllFlagName             = "passthrough-all"
+	EnvAllowlistFlagName               = "env-allowlist"
 	AuthProviderCmdFlagName            = "auth-provider-cmd"
 	NoDestroyDependenciesCheckFlagName = "no-destroy-dependencies-check"
+	PreflightFlagName                  = "preflight"
+	CapturePlanJSONFlagName            = "capture-plan-json"
+	KeepPlanFileFlagName               = "keep-plan-file"
 
 	SourceFlagName       = "source"
 	SourceMapFlagName    = "source-map"
@@ -251,6 +266,7 @@ func NewFlags(opts *options.TerragruntOptions, prefix flags.Prefix) cli.Flags {
 			EnvVars:     tgPrefix.EnvVars(IAMAssumeRoleWebIdentityTokenFlagName),
 			Destination: &opts.IAMRoleOptions.WebIdentityToken,
 			Usage:       "For AssumeRoleWithWebIdentity, the WebIdentity token.",
+			Sensitive:   true,
 		},
 			flags.WithDeprecatedNamesEnvVars(
 				terragruntPrefix.FlagNames(DeprecatedIAMWebIdentityTokenFlagName),
@@ -294,6 +310,13 @@ func NewFlags(opts *options.TerragruntOptions, prefix flags.Prefix) cli.Flags {
 			EnvVars:     tgPrefix.EnvVars(ParallelismFlagName),
 			Destination: &opts.Parallelism,
 			Usage:       "Parallelism for --all commands.",
+			Setter: func(val int) error {
+				if val < 1 {
+					return errors.New("must be greater than 0")
+				}
+
+				return nil
+			},
 		},
 			flags.WithDeprecatedNames(terragruntPrefix.FlagNames(DeprecatedParallelismFlagName), terragruntPrefixControl)),
 
@@ -411,6 +434,48 @@ func NewFlags(opts *options.TerragruntOptions, prefix flags.Prefix) cli.Flags {
 		},
 			flags.WithDeprecatedNames(terragruntPrefix.FlagNames(DeprecatedDisableCommandValidationFlagName), terragruntPrefixControl)),
 
+		flags.NewFlag(&cli.SliceFlag[string]{
+			Name:        AllowCommandFlagName,
+			EnvVars:     tgPrefix.EnvVars(AllowCommandFlagName),
+			Destination: &opts.AllowedCommands,
+			Usage:       "Accept the given tofu/terraform command unknown to Terragrunt, without disabling the command validation. Can be specified multiple times.",
+		}),
+
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        PassthroughAllFlagName,
+			EnvVars:     tgPrefix.EnvVars(PassthroughAllFlagName),
+			Destination: &opts.PassthroughAll,
+			Usage:       "Pass everything after -- to tofu/terraform verbatim, without validating the command or normalizing its flags.",
+		}),
+
+		flags.NewFlag(&cli.SliceFlag[string]{
+			Name:        EnvAllowlistFlagName,
+			EnvVars:     tgPrefix.EnvVars(EnvAllowlistFlagName),
+			Destination: &opts.EnvAllowlist,
+			Usage:       "Pass only the given env vars of the Terragrunt environment to tofu/terraform. Env vars set by Terragrunt are always passed. Can be specified multiple times.",
+		}),
+
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        PreflightFlagName,
+			EnvVars:     tgPrefix.EnvVars(PreflightFlagName),
+			Destination: &opts.Preflight,
+			Usage:       "Check that the tofu/terraform binary exists and is not too old before running the command.",
+		}),
+
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        CapturePlanJSONFlagName,
+			EnvVars:     tgPrefix.EnvVars(CapturePlanJSONFlagName),
+			Destination: &opts.CapturePlanJSON,
+			Usage:       "Write the JSON representation of the plan to the given path after the plan command succeeds.",
+		}),
+
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        KeepPlanFileFlagName,
+			EnvVars:     tgPrefix.EnvVars(KeepPlanFileFlagName),
+			Destination: &opts.KeepPlanFile,
+			Usage:       "Keep the binary plan file created to capture the plan JSON with --" + CapturePlanJSONFlagName + ".",
+		}),
+
 		flags.NewFlag(&cli.BoolFlag{
 			Name:        NoDestroyDependenciesCheckFlagName,
 			EnvVars:     tgPrefix.EnvVars(NoDestroyDependenciesCheckFlagName),
@@ -441,6 +506,7 @@ func NewFlags(opts *options.TerragruntOptions, prefix flags.Prefix) cli.Flags {
 			EnvVars:     tgPrefix.EnvVars(ProviderCacheTokenFlagName),
 			Destination: &opts.ProviderCacheToken,
 			Usage:       "The token for authentication to the Terragrunt Provider Cache server. By default, assigned automatically.",
+			Sensitive:   true,
 		},
 			flags.WithDeprecatedNames(terragruntPrefix.FlagNames(DeprecatedProviderCacheTokenFlagName), terragruntPrefixControl)),
 
@@ -468,6 +534,64 @@ func NewFlags(opts *options.TerragruntOptions, prefix flags.Prefix) cli.Flags {
 		},
 			flags.WithDeprecatedNames(terragruntPrefix.FlagNames(DeprecatedProviderCacheRegistryNamesFlagName), terragruntPrefixControl)),
 
+		flags.NewFlag(&cli.SliceFlag[string]{
+			Name:    TFDefaultArgFlagName,
+			EnvVars: tgPrefix.EnvVars(TFDefaultArgFlagName),
+			Usage:   "Default argument for an OpenTofu/Terraform command in the form <command>=<arg>, e.g. plan=-input=false. Arguments passed by the user take precedence.",
+			Setter: func(value string) error {
+				command, arg, ok := strings.Cut(value, "=")
+				if !ok || command == "" || arg == "" {
+					return errors.Errorf("invalid value %q, expected <command>=<arg>", value)
+				}
+
+				if opts.TerraformDefaultArgs == nil {
+					opts.TerraformDefaultArgs = make(map[string][]string)
+				}
+
+				opts.TerraformDefaultArgs[command] = append(opts.TerraformDefaultArgs[command], arg)
+
+				return nil
+			},
+		}),
+
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:    TFWrapperFlagName,
+			EnvVars: tgPrefix.EnvVars(TFWrapperFlagName),
+			Usage:   "Command to run OpenTofu/Terraform through, e.g. 'time -p'. The binary and its arguments are appended to the wrapper arguments.",
+			Setter: func(value string) error {
+				wrapper, err := shlex.Split(value)
+				if err != nil {
+					return errors.Errorf("invalid wrapper command %q: %w", value, err)
+				}
+
+				opts.TerraformWrapper = wrapper
+
+				return nil
+			},
+		}),
+
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:    InterruptModeFlagName,
+			EnvVars: tgPrefix.EnvVars(InterruptModeFlagName),
+			Usage:   "How to stop OpenTofu/Terraform when Terragrunt is interrupted: 'immediate' kills it at once, 'graceful' sends SIGTERM and waits for it to exit.",
+			Setter: func(val string) error {
+				if !slices.Contains(exec.InterruptModes, exec.InterruptMode(val)) {
+					return errors.Errorf("unsupported interrupt mode %q, supported values: %s, %s", val, exec.InterruptModeImmediate, exec.InterruptModeGraceful)
+				}
+
+				opts.InterruptMode = val
+
+				return nil
+			},
+		}),
+
+		flags.NewFlag(&cli.DurationFlag{
+			Name:        KillTimeoutFlagName,
+			EnvVars:     tgPrefix.EnvVars(KillTimeoutFlagName),
+			Destination: &opts.KillTimeout,
+			Usage:       "How long to wait for OpenTofu/Terraform to exit after SIGTERM on cancellation before killing it. By default, it is not killed and Terragrunt waits for it to exit.",
+		}),
+
 		flags.NewFlag(&cli.GenericFlag[string]{
 			Name:        AuthProviderCmdFlagName,
 			EnvVars:     tgPrefix.EnvVars(AuthProviderCmdFlagName),
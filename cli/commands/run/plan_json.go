@@ -0,0 +1,84 @@
+package run
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/tf"
+	"github.com/gruntwork-io/terragrunt/util"
+)
+
+// CapturedPlanFile is the name of the intermediate binary plan file, created in the working dir, used to capture
+// the plan JSON if the `plan` command is run without the `-out` flag.
+const CapturedPlanFile = ".terragrunt-captured.tfplan"
+
+const planOutFlagName = "out"
+
+// preparePlanJSONCapture adds the `-out` flag to the `plan` command, if the plan JSON is to be captured and the flag is not passed by the user.
+// It returns the path to the plan file and whether the file is intermediate, i.e. it should be removed once the plan JSON is captured.
+// An empty path is returned if the plan JSON is not captured.
+func preparePlanJSONCapture(opts *options.TerragruntOptions) (string, bool) {
+	if opts.CapturePlanJSON == "" || opts.TerraformCliArgs.First() != tf.CommandNamePlan {
+		return "", false
+	}
+
+	args := opts.TerraformCliArgs.Tail()
+
+	for i, arg := range args {
+		if !strings.HasPrefix(arg, "-") || argName(arg) != planOutFlagName {
+			continue
+		}
+
+		planFile, ok := strings.CutPrefix(strings.TrimLeft(arg, "-"), planOutFlagName+"=")
+		if !ok && i+1 < len(args) {
+			planFile = args[i+1]
+		}
+
+		if !filepath.IsAbs(planFile) {
+			planFile = util.JoinPath(opts.WorkingDir, planFile)
+		}
+
+		return planFile, false
+	}
+
+	planFile := util.JoinPath(opts.WorkingDir, CapturedPlanFile)
+	opts.InsertTerraformCliArgs("-" + planOutFlagName + "=" + planFile)
+
+	return planFile, true
+}
+
+// capturePlanJSON runs `show -json` for the given plan file and writes the output to `opts.CapturePlanJSON`.
+// A relative path is resolved against the given unit dir, so that each unit writes its own file when run with `--all`.
+func capturePlanJSON(ctx context.Context, opts *options.TerragruntOptions, unitDir, planFile string) error {
+	showOpts := opts.Clone()
+	showOpts.Writer = io.Discard
+	showOpts.ErrWriter = io.Discard
+	showOpts.TerraformCliArgs = []string{tf.CommandNameShow, tf.FlagNameJSON, planFile}
+
+	output, err := tf.RunCommandWithOutput(ctx, showOpts, showOpts.TerraformCliArgs...)
+	if err != nil {
+		return errors.Errorf("failed to capture the plan JSON of %s: %w", planFile, err)
+	}
+
+	jsonPath := opts.CapturePlanJSON
+	if !filepath.IsAbs(jsonPath) {
+		jsonPath = util.JoinPath(unitDir, jsonPath)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(jsonPath), os.ModePerm); err != nil {
+		return errors.New(err)
+	}
+
+	if err := os.WriteFile(jsonPath, output.Stdout.Bytes(), os.FileMode(0644)); err != nil {
+		return errors.New(err)
+	}
+
+	opts.Logger.Debugf("Captured the plan JSON to %s", jsonPath)
+
+	return nil
+}
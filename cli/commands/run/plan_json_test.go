@@ -0,0 +1,96 @@
+package run_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/cli/commands/run"
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunCapturePlanJSON(t *testing.T) {
+	t.Parallel()
+
+	const planJSON = `{"format_version":"1.2","resource_changes":[{"address":"null_resource.test","change":{"actions":["create"]}}]}`
+
+	// A fake binary that writes the plan file passed with `-out` and prints the same plan JSON on every `show -json`.
+	tfPath := filepath.Join(t.TempDir(), "tofu")
+	require.NoError(t, os.WriteFile(tfPath, []byte(`#!/bin/sh
+case "$1" in
+  -version|version) echo 'OpenTofu v1.8.0' ;;
+  plan)
+    for arg in "$@"; do
+      case "$arg" in -out=*) echo 'binary plan' > "${arg#-out=}" ;; esac
+    done
+    echo 'Plan: 1 to add, 0 to change, 0 to destroy.' ;;
+  show) echo '`+planJSON+`' ;;
+esac
+`), 0755))
+
+	newOpts := func(t *testing.T, args ...string) *options.TerragruntOptions {
+		t.Helper()
+
+		unitDir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(unitDir, "main.tf"), []byte{}, 0644))
+		require.NoError(t, os.MkdirAll(filepath.Join(unitDir, ".terraform"), os.ModePerm))
+
+		configPath := filepath.Join(unitDir, config.DefaultTerragruntConfigPath)
+		require.NoError(t, os.WriteFile(configPath, []byte{}, 0644))
+
+		opts, err := options.NewTerragruntOptionsForTest(configPath)
+		require.NoError(t, err)
+
+		opts.TerraformPath = tfPath
+		opts.TerraformCommand = "plan"
+		opts.TerraformCliArgs = append([]string{"plan"}, args...)
+		opts.CapturePlanJSON = "plan.json"
+
+		return opts
+	}
+
+	t.Run("intermediate plan file is removed", func(t *testing.T) {
+		t.Parallel()
+
+		opts := newOpts(t)
+		unitDir := opts.WorkingDir
+
+		require.NoError(t, run.Run(context.Background(), opts))
+
+		content, err := os.ReadFile(filepath.Join(unitDir, "plan.json"))
+		require.NoError(t, err)
+		assert.JSONEq(t, planJSON, string(content))
+
+		assert.NoFileExists(t, filepath.Join(unitDir, run.CapturedPlanFile))
+	})
+
+	t.Run("intermediate plan file is kept", func(t *testing.T) {
+		t.Parallel()
+
+		opts := newOpts(t)
+		opts.KeepPlanFile = true
+		unitDir := opts.WorkingDir
+
+		require.NoError(t, run.Run(context.Background(), opts))
+
+		assert.FileExists(t, filepath.Join(unitDir, "plan.json"))
+		assert.FileExists(t, filepath.Join(unitDir, run.CapturedPlanFile))
+	})
+
+	t.Run("user plan file is used", func(t *testing.T) {
+		t.Parallel()
+
+		opts := newOpts(t, "-out=my.tfplan")
+		unitDir := opts.WorkingDir
+
+		require.NoError(t, run.Run(context.Background(), opts))
+
+		assert.FileExists(t, filepath.Join(unitDir, "plan.json"))
+		assert.FileExists(t, filepath.Join(unitDir, "my.tfplan"))
+		assert.NoFileExists(t, filepath.Join(unitDir, run.CapturedPlanFile))
+	})
+}
@@ -0,0 +1,27 @@
+package run
+
+import (
+	"context"
+	"os/exec"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+// Preflight checks that the OpenTofu/Terraform binary is usable before anything else is done:
+// it resolves `TerraformPath`, runs `version` and checks the reported version against `DefaultTerraformVersionConstraint`.
+// A `TerraformBinaryNotFoundErr` is returned if the binary is missing or not executable,
+// and an `InvalidTerraformVersion` error if it is too old.
+// The resolved path of the binary is returned, `TerraformPath` is left as is, while `TerraformVersion` is populated as a side effect.
+func Preflight(ctx context.Context, opts *options.TerragruntOptions) (string, error) {
+	tfPath, err := exec.LookPath(opts.TerraformPath)
+	if err != nil {
+		return "", errors.New(TerraformBinaryNotFoundErr{Path: opts.TerraformPath, Err: err})
+	}
+
+	if err := PopulateTerraformVersion(ctx, opts); err != nil {
+		return tfPath, err
+	}
+
+	return tfPath, CheckTerraformVersion(DefaultTerraformVersionConstraint, opts)
+}
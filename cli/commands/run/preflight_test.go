@@ -0,0 +1,85 @@
+package run_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/cli/commands/run"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreflight(t *testing.T) {
+	t.Parallel()
+
+	writeFakeBinary := func(t *testing.T, output string, perm os.FileMode) string {
+		t.Helper()
+
+		tfPath := filepath.Join(t.TempDir(), "tofu")
+		require.NoError(t, os.WriteFile(tfPath, []byte("#!/bin/sh\necho '"+output+"'\n"), perm))
+
+		return tfPath
+	}
+
+	newOpts := func(t *testing.T, tfPath string) *options.TerragruntOptions {
+		t.Helper()
+
+		opts, err := options.NewTerragruntOptionsForTest(filepath.Join(t.TempDir(), "terragrunt.hcl"))
+		require.NoError(t, err)
+
+		opts.TerraformPath = tfPath
+
+		return opts
+	}
+
+	t.Run("supported version", func(t *testing.T) {
+		t.Parallel()
+
+		tfPath := writeFakeBinary(t, "OpenTofu v1.8.0", 0755)
+		opts := newOpts(t, tfPath)
+
+		resolvedPath, err := run.Preflight(context.Background(), opts)
+		require.NoError(t, err)
+		assert.Equal(t, tfPath, resolvedPath)
+		assert.Equal(t, tfPath, opts.TerraformPath)
+		assert.Equal(t, "1.8.0", opts.TerraformVersion.String())
+		assert.Equal(t, options.OpenTofuImpl, opts.TerraformImplementation)
+	})
+
+	t.Run("too old version", func(t *testing.T) {
+		t.Parallel()
+
+		opts := newOpts(t, writeFakeBinary(t, "Terraform v0.11.14", 0755))
+
+		_, err := run.Preflight(context.Background(), opts)
+
+		var versionErr run.InvalidTerraformVersion
+		require.ErrorAs(t, err, &versionErr)
+		assert.Equal(t, "0.11.14", versionErr.CurrentVersion.String())
+	})
+
+	t.Run("missing binary", func(t *testing.T) {
+		t.Parallel()
+
+		tfPath := filepath.Join(t.TempDir(), "missing")
+		_, err := run.Preflight(context.Background(), newOpts(t, tfPath))
+
+		var notFoundErr run.TerraformBinaryNotFoundErr
+		require.ErrorAs(t, err, &notFoundErr)
+		assert.Equal(t, tfPath, notFoundErr.Path)
+	})
+
+	t.Run("not executable", func(t *testing.T) {
+		t.Parallel()
+
+		tfPath := writeFakeBinary(t, "OpenTofu v1.8.0", 0644)
+		_, err := run.Preflight(context.Background(), newOpts(t, tfPath))
+
+		var notFoundErr run.TerraformBinaryNotFoundErr
+		require.ErrorAs(t, err, &notFoundErr)
+		assert.Equal(t, tfPath, notFoundErr.Path)
+	})
+}
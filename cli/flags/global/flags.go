@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"slices"
+	"time"
 
 	"github.com/gruntwork-io/go-commons/collections"
 	"github.com/gruntwork-io/terragrunt/cli/commands"
@@ -12,6 +13,7 @@ import (
 	"github.com/gruntwork-io/terragrunt/cli/commands/version"
 	"github.com/gruntwork-io/terragrunt/cli/flags"
 	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/gruntwork-io/terragrunt/internal/strict"
 	"github.com/gruntwork-io/terragrunt/internal/strict/controls"
 	"github.com/gruntwork-io/terragrunt/options"
@@ -23,12 +25,15 @@ import (
 const (
 	// Logs related flags.
 
-	LogLevelFlagName        = "log-level"
-	LogDisableFlagName      = "log-disable"
-	ShowLogAbsPathsFlagName = "log-show-abs-paths"
-	LogFormatFlagName       = "log-format"
-	LogCustomFormatFlagName = "log-custom-format"
-	NoColorFlagName         = "no-color"
+	LogLevelFlagName              = "log-level"
+	LogDisableFlagName            = "log-disable"
+	ShowLogAbsPathsFlagName       = "log-show-abs-paths"
+	LogFormatFlagName             = "log-format"
+	LogCustomFormatFlagName       = "log-custom-format"
+	LogPrefixWidthFlagName        = "log-prefix-width"
+	LogSamplingFlagName           = "log-sampling-window"
+	LogSamplingPerMessageFlagName = "log-sampling-per-message"
+	NoColorFlagName               = "no-color"
 
 	NonInteractiveFlagName = "non-interactive"
 	WorkingDirFlagName     = "working-dir"
@@ -111,6 +116,11 @@ func NewFlags(opts *options.TerragruntOptions, prefix flags.Prefix) cli.Flags {
 	terragruntPrefixControl := flags.StrictControlsByGlobalFlags(opts.StrictControls)
 	legacyLogsControl := flags.StrictControlsByGlobalFlags(opts.StrictControls, controls.LegacyLogs)
 
+	var (
+		samplingWindow     time.Duration
+		samplingPerMessage = 1
+	)
+
 	flags := cli.Flags{
 		NewLogLevelFlag(opts, prefix),
 
@@ -196,6 +206,58 @@ func NewFlags(opts *options.TerragruntOptions, prefix flags.Prefix) cli.Flags {
 		},
 			flags.WithDeprecatedNames(terragruntPrefix.FlagNames(DeprecatedLogCustomFormatFlagName), terragruntPrefixControl)),
 
+		flags.NewFlag(&cli.GenericFlag[int]{
+			Name:    LogPrefixWidthFlagName,
+			EnvVars: tgPrefix.EnvVars(LogPrefixWidthFlagName),
+			Usage:   "Truncate log prefixes longer than the given width with an ellipsis in the middle, and pad shorter ones to line up messages.",
+			Action: func(_ *cli.Context, val int) error {
+				// Key/value and JSON logs are meant to be parsed, so the prefix is kept as is.
+				if opts.DisableLogFormatting || opts.JSONLogFormat {
+					return nil
+				}
+
+				opts.Logger.Formatter().SetPrefixWidth(val)
+
+				return nil
+			},
+		}),
+
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:    LogSamplingFlagName,
+			EnvVars: tgPrefix.EnvVars(LogSamplingFlagName),
+			Usage:   "Log identical messages only once within the given time window, e.g. 30s, and report how many times they were repeated.",
+			Setter: func(val string) error {
+				window, err := time.ParseDuration(val)
+				if err != nil {
+					return errors.Errorf("invalid duration %q: %w", val, err)
+				}
+
+				samplingWindow = window
+
+				return nil
+			},
+			// The action runs once all flags are parsed, so the per-message limit is known regardless of the flags order.
+			Action: func(_ *cli.Context, _ string) error {
+				opts.Logger.Formatter().SetSampling(samplingPerMessage, samplingWindow)
+
+				return nil
+			},
+		}),
+
+		flags.NewFlag(&cli.GenericFlag[int]{
+			Name:        LogSamplingPerMessageFlagName,
+			EnvVars:     tgPrefix.EnvVars(LogSamplingPerMessageFlagName),
+			Destination: &samplingPerMessage,
+			Usage:       "The number of identical messages logged within the window set by --" + LogSamplingFlagName + ", before the rest are dropped.",
+			Setter: func(val int) error {
+				if val < 1 {
+					return errors.Errorf("must be at least 1, got %d", val)
+				}
+
+				return nil
+			},
+		}),
+
 		flags.NewFlag(&cli.BoolFlag{
 			Name:        NonInteractiveFlagName,
 			EnvVars:     tgPrefix.EnvVars(NonInteractiveFlagName),
@@ -0,0 +1,21 @@
+package flags
+
+import (
+	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+// ProgressJSONFlagName is the name of the flag shared by the commands that report their progress.
+const ProgressJSONFlagName = "progress-json"
+
+// NewProgressJSONFlag creates a flag for emitting the command progress as newline-delimited JSON events to stdout.
+func NewProgressJSONFlag(opts *options.TerragruntOptions, prefix Prefix) *Flag {
+	tgPrefix := prefix.Prepend(TgPrefix)
+
+	return NewFlag(&cli.BoolFlag{
+		Name:        ProgressJSONFlagName,
+		EnvVars:     tgPrefix.EnvVars(ProgressJSONFlagName),
+		Destination: &opts.ProgressJSON,
+		Usage:       "Emit the command progress as newline-delimited JSON events to stdout, the output of OpenTofu/Terraform goes to stderr.",
+	})
+}
@@ -5,7 +5,9 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/gruntwork-io/terragrunt/config"
 	"github.com/gruntwork-io/terragrunt/configstack"
@@ -1313,3 +1315,70 @@ func TestRunModulesReverseOrderMultipleModulesWithDependenciesLargeGraphPartialF
 	assert.True(t, eRan)
 	assert.True(t, fRan)
 }
+
+func TestRunModulesParallelism(t *testing.T) {
+	t.Parallel()
+
+	const parallelism = 2
+
+	var (
+		mu                sync.Mutex
+		running, maxSeen  int
+		finished          = make(map[string]bool)
+		dependencyOrderOK = true
+	)
+
+	// newModule returns a module whose fake executor records how many modules run at the same time.
+	newModule := func(path string, dependencies ...*configstack.TerraformModule) *configstack.TerraformModule {
+		opts, err := options.NewTerragruntOptionsForTest(path)
+		require.NoError(t, err)
+
+		opts.RunTerragrunt = func(context.Context, *options.TerragruntOptions) error {
+			mu.Lock()
+			running++
+			maxSeen = max(maxSeen, running)
+
+			for _, dependency := range dependencies {
+				dependencyOrderOK = dependencyOrderOK && finished[dependency.Path]
+			}
+			mu.Unlock()
+
+			time.Sleep(20 * time.Millisecond)
+
+			mu.Lock()
+			running--
+			finished[path] = true
+			mu.Unlock()
+
+			return nil
+		}
+
+		return &configstack.TerraformModule{
+			Stack:             &configstack.Stack{},
+			Path:              path,
+			Dependencies:      dependencies,
+			TerragruntOptions: opts,
+		}
+	}
+
+	moduleA := newModule("a")
+	moduleB := newModule("b")
+	modules := configstack.TerraformModules{
+		moduleA,
+		moduleB,
+		newModule("c"),
+		newModule("d"),
+		newModule("e", moduleA),
+		newModule("f", moduleA, moduleB),
+	}
+
+	opts, err := options.NewTerragruntOptionsForTest("")
+	require.NoError(t, err)
+
+	err = modules.RunModules(context.Background(), opts, parallelism)
+	require.NoError(t, err)
+
+	assert.Equal(t, parallelism, maxSeen)
+	assert.Len(t, finished, len(modules))
+	assert.True(t, dependencyOrderOK, "a module ran before its dependencies finished")
+}
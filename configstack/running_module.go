@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/gruntwork-io/terragrunt/options"
@@ -44,6 +45,7 @@ type RunningModule struct {
 	Dependencies   map[string]*RunningModule
 	NotifyWhenDone []*RunningModule
 	FlagExcluded   bool
+	Duration       time.Duration
 }
 
 // Create a new RunningModule struct for the given module. This will initialize all fields to reasonable defaults,
@@ -74,13 +76,25 @@ func (module *RunningModule) runModuleWhenReady(ctx context.Context, opts *optio
 		<-semaphore // Remove one from the buffered channel
 	}()
 
+	// Once the run is canceled, e.g. by Ctrl-C, the modules that are already running are shutting down,
+	// and no new modules are started.
+	if err == nil && ctx.Err() != nil {
+		module.Module.TerragruntOptions.Logger.Debugf("Run is canceled, module %s will not be started", module.Module.Path)
+
+		err = errors.New(context.Cause(ctx))
+	}
+
 	if err == nil {
+		startTime := time.Now()
+
 		err = telemetry.Telemetry(ctx, opts, "run_module", map[string]interface{}{
 			"path":             module.Module.Path,
 			"terraformCommand": module.Module.TerragruntOptions.TerraformCommand,
 		}, func(childCtx context.Context) error {
 			return module.runNow(ctx, opts)
 		})
+
+		module.Duration = time.Since(startTime)
 	}
 
 	module.moduleFinished(err)
@@ -313,6 +327,8 @@ func (modules RunningModules) runModules(ctx context.Context, opts *options.Terr
 		semaphore = make(chan struct{}, parallelism) // Make a semaphore from a buffered channel
 	)
 
+	opts.Logger.Debugf("Running %d units with parallelism %d", len(modules), min(parallelism, len(modules)))
+
 	for _, module := range modules {
 		waitGroup.Add(1)
 
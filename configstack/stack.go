@@ -10,6 +10,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -36,6 +37,7 @@ type Stack struct {
 	terragruntOptions     *options.TerragruntOptions
 	childTerragruntConfig *config.TerragruntConfig
 	Modules               TerraformModules
+	unitResults           []UnitResult
 	outputMu              sync.Mutex
 }
 
@@ -123,6 +125,59 @@ func (stack *Stack) LogModuleDeployOrder(logger log.Logger, terraformCommand str
 	return nil
 }
 
+// LogDependencyTrace logs at debug level the resolved dependencies of each module of the stack, and the final order in
+// which the modules will be processed for the given command, to explain why a module was included and where it was ordered.
+func (stack *Stack) LogDependencyTrace(logger log.Logger, terraformCommand string) error {
+	runGraph, err := stack.GetModuleRunGraph(terraformCommand)
+	if err != nil {
+		return err
+	}
+
+	outStr := fmt.Sprintf("Dependencies of the stack at %s:\n", stack.terragruntOptions.WorkingDir)
+
+	modules := slices.Clone(stack.Modules)
+	sort.Slice(modules, func(i, j int) bool {
+		return modules[i].Path < modules[j].Path
+	})
+
+	for _, module := range modules {
+		outStr += fmt.Sprintf("- Module %s", module.Path)
+
+		switch {
+		case module.FlagExcluded:
+			outStr += " (excluded)"
+		case module.AssumeAlreadyApplied:
+			outStr += " (assume already applied)"
+		}
+
+		if len(module.Dependencies) == 0 {
+			outStr += " has no dependencies\n"
+			continue
+		}
+
+		outStr += " depends on:\n"
+
+		for _, dependency := range module.Dependencies {
+			outStr += fmt.Sprintf("  - %s\n", dependency.Path)
+		}
+	}
+
+	outStr += fmt.Sprintf("\nResolved order for command %s:\n", terraformCommand)
+
+	var order int
+
+	for _, group := range runGraph {
+		for _, module := range group {
+			order++
+			outStr += fmt.Sprintf("%d. %s\n", order, module.Path)
+		}
+	}
+
+	logger.Debug(outStr)
+
+	return nil
+}
+
 // JSONModuleDeployOrder will return the modules that will be deployed by a plan/apply operation, in the order
 // that the operations happen.
 func (stack *Stack) JSONModuleDeployOrder(terraformCommand string) (string, error) {
@@ -209,14 +264,25 @@ func (stack *Stack) Run(ctx context.Context, terragruntOptions *options.Terragru
 		defer stack.summarizePlanAllErrors(terragruntOptions, errorStreams)
 	}
 
+	dependencyOrder := NormalOrder
+
 	switch {
 	case terragruntOptions.IgnoreDependencyOrder:
-		return stack.Modules.RunModulesIgnoreOrder(ctx, terragruntOptions, terragruntOptions.Parallelism)
+		dependencyOrder = IgnoreOrder
 	case stackCmd == tf.CommandNameDestroy:
-		return stack.Modules.RunModulesReverseOrder(ctx, terragruntOptions, terragruntOptions.Parallelism)
-	default:
-		return stack.Modules.RunModules(ctx, terragruntOptions, terragruntOptions.Parallelism)
+		dependencyOrder = ReverseOrder
 	}
+
+	runningModules, err := stack.Modules.ToRunningModules(dependencyOrder)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		stack.unitResults = runningModules.toUnitResults(stackCmd)
+	}()
+
+	return runningModules.runModules(ctx, terragruntOptions, terragruntOptions.Parallelism)
 }
 
 // We inspect the error streams to give an explicit message if the plan failed because there were references to
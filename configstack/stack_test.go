@@ -1,7 +1,9 @@
 package configstack_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -13,8 +15,11 @@ import (
 	"github.com/gruntwork-io/terragrunt/configstack"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/pkg/log/format"
 	"github.com/gruntwork-io/terragrunt/tf"
 	"github.com/gruntwork-io/terragrunt/util"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	goerrors "github.com/go-errors/errors"
@@ -1198,3 +1203,144 @@ func TestCircularDependencies(t *testing.T) {
 		t.Errorf("Expected %v, got %v", expected, result)
 	}
 }
+
+func TestLogDependencyTrace(t *testing.T) {
+	t.Parallel()
+
+	var output bytes.Buffer
+
+	formatter := format.NewFormatter(format.NewBareFormatPlaceholders())
+	formatter.SetDisabledColors(true)
+
+	logger := log.New(log.WithOutput(&output), log.WithLevel(log.DebugLevel), log.WithFormatter(formatter))
+
+	stack := createTestStack()
+	require.NoError(t, stack.LogDependencyTrace(logger, tf.CommandNameApply))
+
+	basePath := "/stage/mystack"
+	expected := []string{
+		"- Module " + filepath.Join(basePath, "account-baseline") + " (excluded) has no dependencies",
+		"- Module " + filepath.Join(basePath, "lambda") + " (assume already applied) depends on:\n  - " + filepath.Join(basePath, "vpc"),
+		"- Module " + filepath.Join(basePath, "myapp") + " depends on:\n  - " + filepath.Join(basePath, "mysql") + "\n  - " + filepath.Join(basePath, "redis"),
+		"Resolved order for command apply:\n" +
+			"1. " + filepath.Join(basePath, "vpc") + "\n" +
+			"2. " + filepath.Join(basePath, "mysql") + "\n" +
+			"3. " + filepath.Join(basePath, "redis") + "\n" +
+			"4. " + filepath.Join(basePath, "myapp") + "\n",
+	}
+
+	for _, str := range expected {
+		assert.Contains(t, output.String(), str)
+	}
+}
+
+func TestStackRunWriteUnitResultsJSON(t *testing.T) {
+	t.Parallel()
+
+	aRan := false
+	moduleA := &configstack.TerraformModule{
+		Stack:             &configstack.Stack{},
+		Path:              "a",
+		TerragruntOptions: optionsWithMockTerragruntCommand(t, "a", nil, &aRan),
+	}
+
+	bRan := false
+	moduleB := &configstack.TerraformModule{
+		Stack:             &configstack.Stack{},
+		Path:              "b",
+		Dependencies:      configstack.TerraformModules{moduleA},
+		TerragruntOptions: optionsWithMockTerragruntCommand(t, "b", errors.New("Expected error for module b"), &bRan),
+	}
+
+	cRan := false
+	moduleC := &configstack.TerraformModule{
+		Stack:             &configstack.Stack{},
+		Path:              "c",
+		Dependencies:      configstack.TerraformModules{moduleB},
+		TerragruntOptions: optionsWithMockTerragruntCommand(t, "c", nil, &cRan),
+	}
+
+	opts, err := options.NewTerragruntOptionsForTest("")
+	require.NoError(t, err)
+
+	opts.TerraformCommand = tf.CommandNamePlan
+	opts.TerraformCliArgs = []string{tf.CommandNamePlan}
+
+	stack := configstack.NewStack(opts)
+	stack.Modules = configstack.TerraformModules{moduleA, moduleB, moduleC}
+
+	require.Error(t, stack.Run(context.Background(), opts))
+
+	var output bytes.Buffer
+
+	require.NoError(t, stack.WriteUnitResultsJSON(&output))
+
+	var results []configstack.UnitResult
+
+	require.NoError(t, json.Unmarshal(output.Bytes(), &results))
+	require.Len(t, results, 3)
+
+	assert.Equal(t, "a", results[0].Path)
+	assert.Equal(t, tf.CommandNamePlan, results[0].Command)
+	assert.Equal(t, 0, results[0].ExitCode)
+	assert.Empty(t, results[0].Error)
+	assert.False(t, results[0].SkippedDependencyFailure)
+
+	assert.Equal(t, "b", results[1].Path)
+	assert.Equal(t, 1, results[1].ExitCode)
+	assert.Contains(t, results[1].Error, "Expected error for module b")
+	assert.False(t, results[1].SkippedDependencyFailure)
+
+	assert.Equal(t, "c", results[2].Path)
+	assert.NotZero(t, results[2].ExitCode)
+	assert.True(t, results[2].SkippedDependencyFailure)
+	assert.False(t, cRan)
+}
+
+func TestStackRunCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+
+	errInterrupted := errors.New("interrupt signal received")
+
+	aRan := false
+	moduleA := &configstack.TerraformModule{
+		Stack:             &configstack.Stack{},
+		Path:              "a",
+		TerragruntOptions: optionsWithMockTerragruntCommand(t, "a", nil, &aRan),
+	}
+
+	// simulate the interrupt signal received while module "a" is running
+	moduleA.TerragruntOptions.RunTerragrunt = func(_ context.Context, _ *options.TerragruntOptions) error {
+		aRan = true
+
+		cancel(errInterrupted)
+
+		return nil
+	}
+
+	bRan := false
+	moduleB := &configstack.TerraformModule{
+		Stack:             &configstack.Stack{},
+		Path:              "b",
+		Dependencies:      configstack.TerraformModules{moduleA},
+		TerragruntOptions: optionsWithMockTerragruntCommand(t, "b", nil, &bRan),
+	}
+
+	opts, err := options.NewTerragruntOptionsForTest("")
+	require.NoError(t, err)
+
+	opts.TerraformCommand = tf.CommandNameApply
+	opts.TerraformCliArgs = []string{tf.CommandNameApply}
+
+	stack := configstack.NewStack(opts)
+	stack.Modules = configstack.TerraformModules{moduleA, moduleB}
+
+	err = stack.Run(ctx, opts)
+	require.ErrorIs(t, err, errInterrupted)
+
+	assert.True(t, aRan)
+	assert.False(t, bRan, "module b must not be started after the run is canceled")
+}
@@ -0,0 +1,75 @@
+package configstack
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/util"
+)
+
+// UnitResult describes the outcome of running a unit as part of the stack run.
+type UnitResult struct {
+	Path       string `json:"path"`
+	Command    string `json:"command"`
+	Error      string `json:"error,omitempty"`
+	ExitCode   int    `json:"exit_code"`
+	DurationMS int64  `json:"duration_ms"`
+	// SkippedDependencyFailure is true if the unit was not run because one of its dependencies finished with an error.
+	SkippedDependencyFailure bool `json:"skipped_dependency_failure"`
+}
+
+// UnitResults returns the results of the units processed by the last `Run` call, sorted by the unit path.
+func (stack *Stack) UnitResults() []UnitResult {
+	return stack.unitResults
+}
+
+// WriteUnitResultsJSON writes the results of the units processed by the last `Run` call as a JSON array to the given writer `w`.
+func (stack *Stack) WriteUnitResultsJSON(w io.Writer) error {
+	results := stack.unitResults
+	if results == nil {
+		results = []UnitResult{}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(results); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+func (modules RunningModules) toUnitResults(terraformCommand string) []UnitResult {
+	results := make([]UnitResult, 0, len(modules))
+
+	for _, module := range modules {
+		result := UnitResult{
+			Path:       module.Module.Path,
+			Command:    terraformCommand,
+			DurationMS: module.Duration.Milliseconds(),
+		}
+
+		if module.Err != nil {
+			result.Error = module.Err.Error()
+			result.SkippedDependencyFailure = errors.As(module.Err, new(ProcessingModuleDependencyError))
+
+			exitCode, err := util.GetExitCode(module.Err)
+			if err != nil {
+				exitCode = 1
+			}
+
+			result.ExitCode = exitCode
+		}
+
+		results = append(results, result)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Path < results[j].Path
+	})
+
+	return results
+}
@@ -3,10 +3,17 @@ package cli
 import (
 	libflag "flag"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
+	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/urfave/cli/v2"
 )
 
+// NegatedFlagPrefix is prepended to the names of negatable boolean flags to get the names of their negations.
+const NegatedFlagPrefix = "no-"
+
 // BoolFlag implements Flag
 var _ Flag = new(BoolFlag)
 
@@ -34,6 +41,9 @@ type BoolFlag struct {
 	// If set to true, then the assigned flag value will be inverted.
 	// Example: With `Negative: true`, `--boolean-flag` sets the value to `false`, and `--boolean-flag=false` sets the value to `true`.
 	Negative bool
+	// If set to true, the `--no-<name>` negation is registered for the flag name and each alias, the last specified one wins.
+	// The values of the negatable flag and its env vars can also be specified as "yes" and "no".
+	Negatable bool
 	// Hidden hides the flag from the help, if set to true.
 	Hidden bool
 }
@@ -51,12 +61,21 @@ func (flag *BoolFlag) Apply(set *libflag.FlagSet) error {
 	valueType := newBoolVar(flag.Destination, flag.Negative)
 	value := newGenericValue(valueType, flag.Setter)
 
-	flag.FlagValue = &flagValue{
+	flagValue := &flagValue{
 		value:            value,
 		initialTextValue: value.String(),
 		negative:         flag.Negative,
 	}
 
+	flag.FlagValue = flagValue
+
+	if flag.Negatable {
+		flag.FlagValue = &negatableFlagValue{
+			flagValue:    flagValue,
+			negatedNames: flag.negatedNames(),
+		}
+	}
+
 	return ApplyFlag(flag, set)
 }
 
@@ -67,6 +86,10 @@ func (flag *BoolFlag) GetHidden() bool {
 
 // GetUsage returns the usage string for the flag.
 func (flag *BoolFlag) GetUsage() string {
+	if flag.Negatable {
+		return fmt.Sprintf("%s Use --%s%s to negate.", flag.Usage, NegatedFlagPrefix, flag.Name)
+	}
+
 	return flag.Usage
 }
 
@@ -95,9 +118,27 @@ func (flag *BoolFlag) String() string {
 	return cli.FlagStringer(flag)
 }
 
-// Names returns the names of the flag.
+// Names returns the names of the flag, including the negated names if the flag is negatable.
 func (flag *BoolFlag) Names() []string {
-	return append([]string{flag.Name}, flag.Aliases...)
+	names := append([]string{flag.Name}, flag.Aliases...)
+
+	if flag.Negatable {
+		names = append(names, flag.negatedNames()...)
+	}
+
+	return names
+}
+
+func (flag *BoolFlag) negatedNames() []string {
+	names := make([]string, 0, len(flag.Aliases)+1)
+
+	for _, name := range append([]string{flag.Name}, flag.Aliases...) {
+		if name != "" {
+			names = append(names, NegatedFlagPrefix+name)
+		}
+	}
+
+	return names
 }
 
 // RunAction implements ActionableFlag.RunAction
@@ -161,3 +202,62 @@ func (val *boolVar) String() string {
 
 	return fmt.Sprintf(format, *val.dest)
 }
+
+var _ = FlagValue(new(negatableFlagValue))
+
+// negatableFlagValue is the value of a negatable boolean flag, it returns inverting getters for the negated names.
+type negatableFlagValue struct {
+	*flagValue
+	negatedNames []string
+}
+
+func (flag *negatableFlagValue) Getter(name string) FlagValueGetter {
+	return &negatableFlagValueGetter{
+		flagValueGetter: &flagValueGetter{flagValue: flag.flagValue, valueName: name},
+		negated:         slices.Contains(flag.negatedNames, name),
+	}
+}
+
+func (flag *negatableFlagValue) Set(str string) error {
+	return flag.Getter(flag.name).Set(str)
+}
+
+// negatableFlagValueGetter allows the flag and its negation to be specified multiple times, the last one wins.
+type negatableFlagValueGetter struct {
+	*flagValueGetter
+	negated bool
+}
+
+func (flag *negatableFlagValueGetter) EnvSet(str string) error {
+	return flag.flagValueGetter.EnvSet(normalizeBoolValue(str))
+}
+
+func (flag *negatableFlagValueGetter) Set(str string) error {
+	str = normalizeBoolValue(str)
+
+	if flag.negated {
+		val, err := strconv.ParseBool(str)
+		if err != nil {
+			return errors.New(InvalidValueError{underlyingError: err, msg: `must be one of: "0", "1", "f", "t", "false", "true", "no", "yes"`})
+		}
+
+		str = strconv.FormatBool(!val)
+	}
+
+	flag.hasBeenSet = true
+	flag.flagValue.name = flag.valueName
+
+	return flag.value.Set(str)
+}
+
+// normalizeBoolValue converts "yes" and "no" to their boolean string representations.
+func normalizeBoolValue(str string) string {
+	switch strings.ToLower(str) {
+	case "yes":
+		return "true"
+	case "no":
+		return "false"
+	}
+
+	return str
+}
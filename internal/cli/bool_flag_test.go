@@ -114,6 +114,114 @@ func TestBoolFlagApply(t *testing.T) {
 	}
 }
 
+func TestBoolFlagApplyNegatable(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		flag          cli.BoolFlag
+		args          []string
+		envs          map[string]string
+		expectedValue bool
+		expectedErr   error
+	}{
+		{
+			cli.BoolFlag{Name: "foo", Negatable: true},
+			[]string{"--no-foo"},
+			nil,
+			false,
+			nil,
+		},
+		{
+			cli.BoolFlag{Name: "foo", Destination: mockDestValue(true), Negatable: true},
+			[]string{"--no-foo"},
+			nil,
+			false,
+			nil,
+		},
+		{
+			cli.BoolFlag{Name: "foo", Negatable: true},
+			[]string{"--no-foo", "--foo"},
+			nil,
+			true,
+			nil,
+		},
+		{
+			cli.BoolFlag{Name: "foo", Negatable: true},
+			[]string{"--foo", "--no-foo"},
+			nil,
+			false,
+			nil,
+		},
+		{
+			cli.BoolFlag{Name: "foo", Aliases: []string{"f"}, Negatable: true},
+			[]string{"--f", "--no-f"},
+			nil,
+			false,
+			nil,
+		},
+		{
+			cli.BoolFlag{Name: "foo", Negatable: true},
+			[]string{"--no-foo=false"},
+			nil,
+			true,
+			nil,
+		},
+		{
+			cli.BoolFlag{Name: "foo", EnvVars: []string{"FOO"}, Negatable: true},
+			[]string{"--no-foo"},
+			map[string]string{"FOO": "true"},
+			false,
+			nil,
+		},
+		{
+			cli.BoolFlag{Name: "foo", EnvVars: []string{"FOO"}, Negatable: true},
+			[]string{"--foo"},
+			map[string]string{"FOO": "no"},
+			true,
+			nil,
+		},
+		{
+			cli.BoolFlag{Name: "foo", EnvVars: []string{"FOO"}, Negatable: true},
+			nil,
+			map[string]string{"FOO": "yes"},
+			true,
+			nil,
+		},
+		{
+			cli.BoolFlag{Name: "foo", EnvVars: []string{"FOO"}, Destination: mockDestValue(true), Negatable: true},
+			nil,
+			map[string]string{"FOO": "0"},
+			false,
+			nil,
+		},
+		{
+			cli.BoolFlag{Name: "foo", Negatable: true},
+			[]string{"--no-foo=monkey"},
+			nil,
+			false,
+			errors.New(`invalid boolean value "monkey" for -no-foo: must be one of: "0", "1", "f", "t", "false", "true", "no", "yes"`),
+		},
+	}
+
+	for i, testCase := range testCases {
+		t.Run(fmt.Sprintf("testCase-%d", i), func(t *testing.T) {
+			t.Parallel()
+
+			testBoolFlagApply(t, &testCase.flag, testCase.args, testCase.envs, testCase.expectedValue, testCase.expectedErr)
+		})
+	}
+}
+
+func TestBoolFlagNegatableHelp(t *testing.T) {
+	t.Parallel()
+
+	flag := &cli.BoolFlag{Name: "foo", Aliases: []string{"f"}, Usage: "Enable foo.", Negatable: true}
+
+	assert.Equal(t, []string{"foo", "f", "no-foo", "no-f"}, flag.Names())
+	assert.Equal(t, "Enable foo. Use --no-foo to negate.", flag.GetUsage())
+	assert.Contains(t, flag.String(), "--no-foo")
+}
+
 func testBoolFlagApply(t *testing.T, flag *cli.BoolFlag, args []string, envs map[string]string, expectedValue bool, expectedErr error) {
 	t.Helper()
 
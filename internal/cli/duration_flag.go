@@ -0,0 +1,190 @@
+package cli
+
+import (
+	libflag "flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/urfave/cli/v2"
+)
+
+// DurationFlag implements Flag
+var _ Flag = new(DurationFlag)
+
+// DurationFlag is a flag whose value is a Go duration string, such as `30s`, `5m` or `1h30m`.
+// A value without a unit, such as `30`, is rejected instead of being interpreted as nanoseconds.
+type DurationFlag struct {
+	flag
+
+	// The name of the flag.
+	Name string
+	// The default value of the flag to display in the help, if it is empty, the value is taken from `Destination`.
+	DefaultText string
+	// A short usage description to display in help.
+	Usage string
+	// Aliases are usually used for the short flag name, like `-h`.
+	Aliases []string
+	// The names of the env variables that are parsed and assigned to `Destination` before the flag value.
+	EnvVars []string
+	// Action is a function that is called when the flag is specified. It is executed only after all command flags have been parsed.
+	Action FlagActionFunc[time.Duration]
+	// Setter allows to set a value to any type by calling its `func(time.Duration) error` function.
+	Setter FlagSetterFunc[time.Duration]
+	// Destination is a pointer to which the value of the flag or env var is assigned.
+	// It also uses as the default value displayed in the help.
+	Destination *time.Duration
+	// Min is the minimum allowed value, zero means no minimum.
+	Min time.Duration
+	// Max is the maximum allowed value, zero means no maximum.
+	Max time.Duration
+	// Hidden hides the flag from the help, if set to true.
+	Hidden bool
+}
+
+// Apply applies Flag settings to the given flag set.
+func (flag *DurationFlag) Apply(set *libflag.FlagSet) error {
+	if flag.FlagValue != nil {
+		return ApplyFlag(flag, set)
+	}
+
+	if flag.Destination == nil {
+		flag.Destination = new(time.Duration)
+	}
+
+	valueType := &durationVar{dest: flag.Destination, min: flag.Min, max: flag.Max}
+	value := newGenericValue(valueType, flag.Setter)
+
+	flag.FlagValue = &flagValue{
+		value:            value,
+		initialTextValue: value.String(),
+	}
+
+	return ApplyFlag(flag, set)
+}
+
+// GetHidden returns true if the flag should be hidden from the help.
+func (flag *DurationFlag) GetHidden() bool {
+	return flag.Hidden
+}
+
+// GetUsage returns the usage string for the flag.
+func (flag *DurationFlag) GetUsage() string {
+	return flag.Usage
+}
+
+// GetEnvVars implements `cli.Flag` interface.
+func (flag *DurationFlag) GetEnvVars() []string {
+	return flag.EnvVars
+}
+
+// GetDefaultText returns the default duration in a human readable form, e.g. `1h30m` rather than `1h30m0s`.
+func (flag *DurationFlag) GetDefaultText() string {
+	if flag.DefaultText == "" && flag.FlagValue != nil {
+		return flag.FlagValue.GetInitialTextValue()
+	}
+
+	return flag.DefaultText
+}
+
+// String returns a readable representation of this value (for usage defaults).
+func (flag *DurationFlag) String() string {
+	return cli.FlagStringer(flag)
+}
+
+// Names returns the names of the flag.
+func (flag *DurationFlag) Names() []string {
+	return append([]string{flag.Name}, flag.Aliases...)
+}
+
+// RunAction implements ActionableFlag.RunAction
+func (flag *DurationFlag) RunAction(ctx *Context) error {
+	dest := flag.Destination
+	if dest == nil {
+		dest = new(time.Duration)
+	}
+
+	if flag.Action != nil {
+		return flag.Action(ctx, *dest)
+	}
+
+	return nil
+}
+
+var _ = FlagVariable[time.Duration](new(durationVar))
+
+// -- duration Type
+type durationVar struct {
+	dest *time.Duration
+	min  time.Duration
+	max  time.Duration
+}
+
+func (val *durationVar) Clone(dest *time.Duration) FlagVariable[time.Duration] {
+	if dest == nil {
+		dest = new(time.Duration)
+	}
+
+	return &durationVar{dest: dest, min: val.min, max: val.max}
+}
+
+func (val *durationVar) Set(str string) error {
+	if val.dest == nil {
+		val.dest = new(time.Duration)
+	}
+
+	v, err := time.ParseDuration(str)
+	if err != nil {
+		if _, numErr := strconv.ParseFloat(str, 64); numErr == nil {
+			return errors.New(InvalidValueError{underlyingError: err, msg: fmt.Sprintf("missing unit in duration %q, e.g. %ss for seconds or %sm for minutes", str, str, str)})
+		}
+
+		return errors.New(InvalidValueError{underlyingError: err, msg: "must be a duration with a unit, e.g. 30s, 5m or 1h30m"})
+	}
+
+	if val.min != 0 && v < val.min {
+		return errors.Errorf("must be at least %s", formatDuration(val.min))
+	}
+
+	if val.max != 0 && v > val.max {
+		return errors.Errorf("must be at most %s", formatDuration(val.max))
+	}
+
+	*val.dest = v
+
+	return nil
+}
+
+func (val *durationVar) Get() any {
+	if val.dest == nil {
+		return time.Duration(0)
+	}
+
+	return *val.dest
+}
+
+// String returns a readable representation of this value
+func (val *durationVar) String() string {
+	if val.dest == nil {
+		return ""
+	}
+
+	return formatDuration(*val.dest)
+}
+
+// formatDuration returns the duration without the trailing zero units, e.g. `1h` rather than `1h0m0s`.
+func formatDuration(d time.Duration) string {
+	str := d.String()
+
+	if trimmed, ok := strings.CutSuffix(str, "m0s"); ok {
+		str = trimmed + "m"
+	}
+
+	if trimmed, ok := strings.CutSuffix(str, "h0m"); ok {
+		str = trimmed + "h"
+	}
+
+	return str
+}
@@ -0,0 +1,124 @@
+package cli_test
+
+import (
+	libflag "flag"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDurationFlagApply(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		args          []string
+		envs          map[string]string
+		expectedValue time.Duration
+		expectedErr   string
+	}{
+		{
+			name:          "valid arg",
+			args:          []string{"--timeout", "1m30s"},
+			expectedValue: 90 * time.Second,
+		},
+		{
+			name:          "valid env",
+			envs:          map[string]string{"TIMEOUT": "5m"},
+			expectedValue: 5 * time.Minute,
+		},
+		{
+			name:          "default",
+			expectedValue: 10 * time.Minute,
+		},
+		{
+			name:        "missing unit in arg",
+			args:        []string{"--timeout", "30"},
+			expectedErr: `invalid value "30" for flag -timeout: missing unit in duration "30", e.g. 30s for seconds or 30m for minutes`,
+		},
+		{
+			name:        "missing unit in env",
+			envs:        map[string]string{"TIMEOUT": "30"},
+			expectedErr: `invalid value "30" for env var TIMEOUT: missing unit in duration "30"`,
+		},
+		{
+			name:        "not a duration",
+			args:        []string{"--timeout", "soon"},
+			expectedErr: "must be a duration with a unit, e.g. 30s, 5m or 1h30m",
+		},
+		{
+			name:        "below minimum",
+			args:        []string{"--timeout", "500ms"},
+			expectedErr: `invalid value "500ms" for flag -timeout: must be at least 1s`,
+		},
+		{
+			name:        "above maximum",
+			envs:        map[string]string{"TIMEOUT": "3h"},
+			expectedErr: `invalid value "3h" for env var TIMEOUT: must be at most 1h`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			flag := &cli.DurationFlag{
+				Name:        "timeout",
+				EnvVars:     []string{"TIMEOUT"},
+				Destination: mockDestValue(10 * time.Minute),
+				Min:         time.Second,
+				Max:         time.Hour,
+			}
+			flag.LookupEnvFunc = mockLookupEnvFunc(tc.envs, false)
+
+			flagSet := libflag.NewFlagSet("test-cmd", libflag.ContinueOnError)
+			flagSet.SetOutput(io.Discard)
+
+			err := flag.Apply(flagSet)
+			if err == nil {
+				err = flagSet.Parse(tc.args)
+			}
+
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedValue, *flag.Destination)
+			assert.Equal(t, tc.expectedValue, flag.Value().Get())
+			assert.Equal(t, len(tc.args) > 0 || len(tc.envs) > 0, flag.Value().IsSet(), "IsSet()")
+			assert.Equal(t, "10m", flag.GetDefaultText())
+		})
+	}
+}
+
+func TestDurationFlagDefaultText(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		value    time.Duration
+		expected string
+	}{
+		{0, "0s"},
+		{30 * time.Second, "30s"},
+		{5 * time.Minute, "5m"},
+		{2 * time.Hour, "2h"},
+		{90 * time.Minute, "1h30m"},
+		{time.Hour + time.Second, "1h0m1s"},
+		{1500 * time.Millisecond, "1.5s"},
+	}
+
+	for _, tc := range testCases {
+		flag := &cli.DurationFlag{Name: "timeout", Destination: mockDestValue(tc.value)}
+		require.NoError(t, flag.Apply(libflag.NewFlagSet("test-cmd", libflag.ContinueOnError)))
+
+		assert.Equal(t, tc.expected, flag.GetDefaultText())
+		assert.Contains(t, flag.String(), "(default: "+tc.expected+")")
+	}
+}
@@ -0,0 +1,156 @@
+package cli
+
+import (
+	libflag "flag"
+	"os"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/urfave/cli/v2"
+)
+
+// FileBackedFlagPrefix is the prefix of a flag or env var value that specifies the path to the file to read the value from.
+const FileBackedFlagPrefix = "@"
+
+// FileBackedFlag implements Flag
+var _ Flag = new(FileBackedFlag[string])
+
+// FileBackedFlag is a flag whose value can be specified directly or read from a file by using the `@/path/to/file` form,
+// for both the flag and its env vars. The file content is read and trimmed at parse time.
+type FileBackedFlag[T GenericType] struct {
+	flag
+
+	// The name of the flag.
+	Name string
+	// The default value of the flag to display in the help, if it is empty, the value is taken from `Destination`.
+	DefaultText string
+	// A short usage description to display in help.
+	Usage string
+	// Aliases are usually used for the short flag name, like `-h`.
+	Aliases []string
+	// The names of the env variables that are parsed and assigned to `Destination` before the flag value.
+	EnvVars []string
+	// Action is a function that is called when the flag is specified. It is executed only after all command flags have been parsed.
+	Action FlagActionFunc[T]
+	// Setter allows to set a value to any type by calling its `func(bool) error` function.
+	Setter FlagSetterFunc[T]
+	// Destination is a pointer to which the value of the flag or env var is assigned.
+	// It also uses as the default value displayed in the help.
+	Destination *T
+	// Hidden hides the flag from the help, if set to true.
+	Hidden bool
+}
+
+// Apply applies Flag settings to the given flag set.
+func (flag *FileBackedFlag[T]) Apply(set *libflag.FlagSet) error {
+	if flag.FlagValue != nil {
+		return ApplyFlag(flag, set)
+	}
+
+	if flag.Destination == nil {
+		flag.Destination = new(T)
+	}
+
+	valueType := &fileBackedVar[T]{genericVar: &genericVar[T]{dest: flag.Destination}}
+	value := newGenericValue(valueType, flag.Setter)
+
+	flag.FlagValue = &flagValue{
+		value:            value,
+		initialTextValue: value.String(),
+	}
+
+	return ApplyFlag(flag, set)
+}
+
+// GetHidden returns true if the flag should be hidden from the help.
+func (flag *FileBackedFlag[T]) GetHidden() bool {
+	return flag.Hidden
+}
+
+// GetUsage returns the usage string for the flag.
+func (flag *FileBackedFlag[T]) GetUsage() string {
+	return flag.Usage
+}
+
+// GetEnvVars implements `cli.Flag` interface.
+func (flag *FileBackedFlag[T]) GetEnvVars() []string {
+	return flag.EnvVars
+}
+
+// GetDefaultText returns the flags value as string representation and an empty string if the flag takes no value at all.
+// The value read from a file is never returned, only the `@/path/to/file` it was read from.
+func (flag *FileBackedFlag[T]) GetDefaultText() string {
+	if flag.DefaultText == "" && flag.FlagValue != nil {
+		return flag.FlagValue.GetInitialTextValue()
+	}
+
+	return flag.DefaultText
+}
+
+// String returns a readable representation of this value (for usage defaults).
+func (flag *FileBackedFlag[T]) String() string {
+	return cli.FlagStringer(flag)
+}
+
+// Names returns the names of the flag.
+func (flag *FileBackedFlag[T]) Names() []string {
+	return append([]string{flag.Name}, flag.Aliases...)
+}
+
+// RunAction implements ActionableFlag.RunAction
+func (flag *FileBackedFlag[T]) RunAction(ctx *Context) error {
+	dest := flag.Destination
+	if dest == nil {
+		dest = new(T)
+	}
+
+	if flag.Action != nil {
+		return flag.Action(ctx, *dest)
+	}
+
+	return nil
+}
+
+var _ = FlagVariable[string](new(fileBackedVar[string]))
+
+// -- file backed Type
+type fileBackedVar[T comparable] struct {
+	*genericVar[T]
+	// filePath is the path of the file the value was read from, empty if the value was specified directly.
+	filePath string
+}
+
+func (val *fileBackedVar[T]) Clone(dest *T) FlagVariable[T] {
+	if dest == nil {
+		dest = new(T)
+	}
+
+	return &fileBackedVar[T]{genericVar: &genericVar[T]{dest: dest}}
+}
+
+func (val *fileBackedVar[T]) Set(str string) error {
+	filePath, ok := strings.CutPrefix(str, FileBackedFlagPrefix)
+	if !ok {
+		val.filePath = ""
+
+		return val.genericVar.Set(str)
+	}
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return errors.Errorf("failed to read value from file %q: %w", filePath, err)
+	}
+
+	val.filePath = filePath
+
+	return val.genericVar.Set(strings.TrimSpace(string(content)))
+}
+
+// String returns a readable representation of this value, the file path if the value was read from a file.
+func (val *fileBackedVar[T]) String() string {
+	if val.filePath != "" {
+		return FileBackedFlagPrefix + val.filePath
+	}
+
+	return val.genericVar.String()
+}
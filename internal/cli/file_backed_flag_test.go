@@ -0,0 +1,105 @@
+package cli_test
+
+import (
+	libflag "flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileBackedFlagApply(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := t.TempDir()
+
+	secretFile := filepath.Join(tmpDir, "secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("  file-value\n"), 0600))
+
+	missingFile := filepath.Join(tmpDir, "missing")
+
+	testCases := []struct {
+		flag          cli.FileBackedFlag[string]
+		args          []string
+		envs          map[string]string
+		expectedValue string
+		expectedText  string
+		expectedErr   string
+	}{
+		{
+			flag:          cli.FileBackedFlag[string]{Name: "foo", EnvVars: []string{"FOO"}},
+			args:          []string{"--foo", "arg-value"},
+			expectedValue: "arg-value",
+			expectedText:  "arg-value",
+		},
+		{
+			flag:          cli.FileBackedFlag[string]{Name: "foo", EnvVars: []string{"FOO"}},
+			args:          []string{"--foo", "@" + secretFile},
+			expectedValue: "file-value",
+			expectedText:  "@" + secretFile,
+		},
+		{
+			flag:          cli.FileBackedFlag[string]{Name: "foo", EnvVars: []string{"FOO"}},
+			envs:          map[string]string{"FOO": "@" + secretFile},
+			expectedValue: "file-value",
+			expectedText:  "@" + secretFile,
+		},
+		{
+			flag:          cli.FileBackedFlag[string]{Name: "foo", EnvVars: []string{"FOO"}},
+			args:          []string{"--foo", "arg-value"},
+			envs:          map[string]string{"FOO": "@" + secretFile},
+			expectedValue: "arg-value",
+			expectedText:  "arg-value",
+		},
+		{
+			flag:        cli.FileBackedFlag[string]{Name: "foo", EnvVars: []string{"FOO"}},
+			args:        []string{"--foo", "@" + missingFile},
+			expectedErr: fmt.Sprintf(`invalid value "@%[1]s" for flag -foo: failed to read value from file %[1]q`, missingFile),
+		},
+		{
+			flag:        cli.FileBackedFlag[string]{Name: "foo", EnvVars: []string{"FOO"}},
+			envs:        map[string]string{"FOO": "@" + missingFile},
+			expectedErr: fmt.Sprintf(`invalid value "@%[1]s" for env var FOO: failed to read value from file %[1]q`, missingFile),
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("testCase-%d", i), func(t *testing.T) {
+			t.Parallel()
+
+			flag := &tc.flag
+			flag.LookupEnvFunc = func(key string) []string {
+				if val, ok := tc.envs[key]; ok {
+					return []string{val}
+				}
+
+				return nil
+			}
+
+			flagSet := libflag.NewFlagSet("test-cmd", libflag.ContinueOnError)
+			flagSet.SetOutput(io.Discard)
+
+			err := flag.Apply(flagSet)
+			if err == nil {
+				err = flagSet.Parse(tc.args)
+			}
+
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedValue, flag.Value().Get())
+			assert.Equal(t, tc.expectedText, flag.GetValue(), "GetValue()")
+			assert.Empty(t, flag.GetDefaultText(), "GetDefaultText()")
+			assert.NotContains(t, flag.String(), "file-value")
+		})
+	}
+}
@@ -10,6 +10,9 @@ import (
 	"github.com/urfave/cli/v2"
 )
 
+// SensitiveValueMask is displayed instead of the values of the sensitive flags.
+const SensitiveValueMask = "***"
+
 var (
 	// FlagSplitter uses to separate arguments and env vars with multiple values.
 	FlagSplitter = strings.Split
@@ -62,6 +65,9 @@ type FlagValue interface {
 	// IsEnvSet returns true if the flag was set by env var.
 	IsEnvSet() bool
 
+	// GetEnvVar returns the name of the env var that supplied the value, or an empty string if the flag was not set by env var.
+	GetEnvVar() string
+
 	// IsBoolFlag returns true if the flag is of type bool.
 	IsBoolFlag() bool
 
@@ -71,6 +77,10 @@ type FlagValue interface {
 
 	// MultipleSet returns true if the flag allows multiple assignments, such as slice/map.
 	MultipleSet() bool
+
+	// IsSensitive returns true if the value must not be displayed, e.g. in help or error messages.
+	// `String` still returns the real value, use `DisplayValue` to get a displayable one.
+	IsSensitive() bool
 }
 
 type Flag interface {
@@ -117,6 +127,7 @@ func (flag *flagValueGetter) EnvSet(val string) error {
 	}
 
 	flag.flagValue.name = flag.valueName
+	flag.flagValue.envVar = flag.valueName
 
 	return flag.flagValue.value.Set(val)
 }
@@ -145,10 +156,12 @@ type flagValue struct {
 	value            Value
 	multipleSet      bool
 	name             string
+	envVar           string
 	hasBeenSet       bool
 	envHasBeenSet    bool
 	initialTextValue string
 	negative         bool
+	sensitive        bool
 }
 
 func (flag *flagValue) MultipleSet() bool {
@@ -198,6 +211,14 @@ func (flag *flagValue) IsEnvSet() bool {
 	return flag.envHasBeenSet
 }
 
+func (flag *flagValue) GetEnvVar() string {
+	return flag.envVar
+}
+
+func (flag *flagValue) IsSensitive() bool {
+	return flag.sensitive
+}
+
 func (flag *flagValue) GetName() string {
 	return flag.name
 }
@@ -240,7 +261,7 @@ func (flag *flag) TakesValue() bool {
 // string if the flag takes no value at all.
 // Implements `cli.DocGenerationFlag.GetValue` required to generate help.
 func (flag *flag) GetValue() string {
-	return flag.FlagValue.String()
+	return DisplayValue(flag.FlagValue, flag.FlagValue.String())
 }
 
 // GetCategory returns the category for the flag.
@@ -259,14 +280,14 @@ func (flag *flag) SplitValue(val string) []string {
 }
 
 func ApplyFlag(flag Flag, set *libflag.FlagSet) error {
-	for _, name := range flag.GetEnvVars() {
-		for _, val := range flag.LookupEnv(name) {
-			if val == "" || (flag.Value().IsEnvSet() && !flag.Value().MultipleSet()) {
-				continue
-			}
-
-			if err := flag.Value().Getter(name).EnvSet(val); err != nil {
-				return errors.Errorf("invalid value %q for env var %s: %w", val, name, err)
+	// The value may already be set by the env var of the flag that shares the same value, e.g. a deprecated one,
+	// which takes precedence since it was applied first.
+	if !flag.Value().IsEnvSet() {
+		if name, vals := LookupFirstEnv(flag); name != "" {
+			for _, val := range vals {
+				if err := flag.Value().Getter(name).EnvSet(val); err != nil {
+					return errors.Errorf("invalid value %q for env var %s: %w", DisplayValue(flag.Value(), val), name, err)
+				}
 			}
 		}
 	}
@@ -279,3 +300,33 @@ func ApplyFlag(flag Flag, set *libflag.FlagSet) error {
 
 	return nil
 }
+
+// LookupFirstEnv looks up the env vars of the flag in the order they are declared and returns the name and
+// the non-empty values of the first one that is set. For example, for a flag with `EnvVars: []string{"TG_FOO", "TERRAGRUNT_FOO"}`,
+// `TG_FOO` takes precedence over `TERRAGRUNT_FOO` if both are set.
+func LookupFirstEnv(flag Flag) (string, []string) {
+	for _, name := range flag.GetEnvVars() {
+		var vals []string
+
+		for _, val := range flag.LookupEnv(name) {
+			if val != "" {
+				vals = append(vals, val)
+			}
+		}
+
+		if len(vals) > 0 {
+			return name, vals
+		}
+	}
+
+	return "", nil
+}
+
+// DisplayValue returns the given `val` of the flag value, or `SensitiveValueMask` if the flag value is sensitive and `val` is not empty.
+func DisplayValue(flagValue FlagValue, val string) string {
+	if val != "" && flagValue != nil && flagValue.IsSensitive() {
+		return SensitiveValueMask
+	}
+
+	return val
+}
@@ -1,5 +1,144 @@
 package cli_test
 
+import (
+	"bytes"
+	"context"
+	libflag "flag"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/pkg/log/format"
+	"github.com/gruntwork-io/terragrunt/pkg/log/format/placeholders"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
 func mockDestValue[T any](val T) *T {
 	return &val
 }
+
+func mockLookupEnvFunc(envs map[string]string, split bool) cli.LookupEnvFuncType {
+	return func(key string) []string {
+		val, ok := envs[key]
+		if !ok {
+			return nil
+		}
+
+		if split {
+			return strings.Split(val, ",")
+		}
+
+		return []string{val}
+	}
+}
+
+func TestFlagEnvVarsPrecedence(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		envs           map[string]string
+		expectedValue  string
+		expectedEnvVar string
+	}{
+		{
+			map[string]string{"TG_FOO": "tg-value", "TERRAGRUNT_FOO": "terragrunt-value"},
+			"tg-value",
+			"TG_FOO",
+		},
+		{
+			map[string]string{"TERRAGRUNT_FOO": "terragrunt-value"},
+			"terragrunt-value",
+			"TERRAGRUNT_FOO",
+		},
+		{
+			map[string]string{"TG_FOO": "", "TERRAGRUNT_FOO": "terragrunt-value"},
+			"terragrunt-value",
+			"TERRAGRUNT_FOO",
+		},
+		{
+			nil,
+			"",
+			"",
+		},
+	}
+
+	for i, testCase := range testCases {
+		t.Run(fmt.Sprintf("testCase-%d", i), func(t *testing.T) {
+			t.Parallel()
+
+			flag := &cli.GenericFlag[string]{
+				Name:        "foo",
+				EnvVars:     []string{"TG_FOO", "TERRAGRUNT_FOO"},
+				Destination: new(string),
+			}
+			flag.LookupEnvFunc = mockLookupEnvFunc(testCase.envs, false)
+
+			flagSet := libflag.NewFlagSet("test-cmd", libflag.ContinueOnError)
+			flagSet.SetOutput(io.Discard)
+
+			require.NoError(t, flag.Apply(flagSet))
+
+			assert.Equal(t, testCase.expectedValue, flag.Value().Get())
+			assert.Equal(t, testCase.expectedEnvVar, flag.Value().GetEnvVar())
+			assert.Equal(t, testCase.expectedEnvVar != "", flag.Value().IsSet(), "IsSet()")
+			assert.Equal(t, testCase.expectedEnvVar != "", flag.Value().IsEnvSet(), "IsEnvSet()")
+		})
+	}
+}
+
+func TestSliceFlagEnvVarsPrecedence(t *testing.T) {
+	t.Parallel()
+
+	flag := &cli.SliceFlag[string]{
+		Name:        "foo",
+		EnvVars:     []string{"TG_FOO", "TERRAGRUNT_FOO"},
+		Destination: new([]string),
+	}
+	flag.LookupEnvFunc = mockLookupEnvFunc(map[string]string{
+		"TG_FOO":         "tg-value1,tg-value2",
+		"TERRAGRUNT_FOO": "terragrunt-value",
+	}, true)
+
+	flagSet := libflag.NewFlagSet("test-cmd", libflag.ContinueOnError)
+	flagSet.SetOutput(io.Discard)
+
+	require.NoError(t, flag.Apply(flagSet))
+
+	assert.Equal(t, []string{"tg-value1", "tg-value2"}, flag.Value().Get())
+	assert.Equal(t, "TG_FOO", flag.Value().GetEnvVar())
+}
+
+func TestFlagsRunActionsLogsEnvVar(t *testing.T) {
+	t.Parallel()
+
+	formatter := format.NewFormatter(placeholders.Placeholders{placeholders.Message()})
+	output := new(bytes.Buffer)
+	logger := log.New(log.WithOutput(output), log.WithLevel(log.DebugLevel), log.WithFormatter(formatter))
+
+	fooFlag := &cli.GenericFlag[string]{Name: "foo", EnvVars: []string{"TG_FOO", "TERRAGRUNT_FOO"}}
+	fooFlag.LookupEnvFunc = mockLookupEnvFunc(map[string]string{"TERRAGRUNT_FOO": "terragrunt-value"}, false)
+
+	barFlag := &cli.GenericFlag[string]{Name: "bar", EnvVars: []string{"TG_BAR"}}
+	barFlag.LookupEnvFunc = mockLookupEnvFunc(map[string]string{"TG_BAR": "env-value"}, false)
+
+	flags := cli.Flags{fooFlag, barFlag}
+
+	flagSet := libflag.NewFlagSet("test-cmd", libflag.ContinueOnError)
+	flagSet.SetOutput(io.Discard)
+
+	for _, flag := range flags {
+		require.NoError(t, flag.Apply(flagSet))
+	}
+
+	require.NoError(t, flagSet.Parse([]string{"--bar", "arg-value"}))
+
+	ctx := cli.NewAppContext(log.ContextWithLogger(context.Background(), logger), cli.NewApp(), nil)
+	require.NoError(t, flags.RunActions(ctx))
+
+	assert.Contains(t, output.String(), "Flag --foo is set by env var TERRAGRUNT_FOO")
+	assert.NotContains(t, output.String(), "--bar")
+}
@@ -6,6 +6,7 @@ import (
 	"sort"
 
 	"github.com/gruntwork-io/go-commons/collections"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
 )
 
 type Flags []Flag
@@ -97,6 +98,8 @@ func (flags Flags) Swap(i, j int) {
 func (flags Flags) RunActions(ctx *Context) error {
 	for _, flag := range flags {
 		if flag.Value().IsSet() {
+			logEnvVarSource(ctx, flag)
+
 			if err := flag.RunAction(ctx); err != nil {
 				return err
 			}
@@ -106,6 +109,17 @@ func (flags Flags) RunActions(ctx *Context) error {
 	return nil
 }
 
+// logEnvVarSource logs at debug level which env var supplied the flag value, if the flag was set by env var only.
+func logEnvVarSource(ctx *Context, flag Flag) {
+	if ctx == nil || ctx.Context == nil || !flag.Value().IsEnvSet() || flag.Value().IsArgSet() || len(flag.Names()) == 0 {
+		return
+	}
+
+	if logger := log.LoggerFromContext(ctx); logger != nil {
+		logger.Debugf("Flag --%s is set by env var %s", flag.Names()[0], flag.Value().GetEnvVar())
+	}
+}
+
 func (flags Flags) Sort() Flags {
 	sort.Sort(flags)
 
@@ -38,6 +38,9 @@ type GenericFlag[T GenericType] struct {
 	Destination *T
 	// Hidden hides the flag from the help, if set to true.
 	Hidden bool
+	// Sensitive masks the value of the flag in the help and error messages, e.g. for tokens.
+	// The real value is still assigned to `Destination`.
+	Sensitive bool
 }
 
 // Apply applies Flag settings to the given flag set.
@@ -56,6 +59,7 @@ func (flag *GenericFlag[T]) Apply(set *libflag.FlagSet) error {
 	flag.FlagValue = &flagValue{
 		value:            value,
 		initialTextValue: value.String(),
+		sensitive:        flag.Sensitive,
 	}
 
 	return ApplyFlag(flag, set)
@@ -79,7 +83,7 @@ func (flag *GenericFlag[T]) GetEnvVars() []string {
 // GetDefaultText returns the flags value as string representation and an empty string if the flag takes no value at all.
 func (flag *GenericFlag[T]) GetDefaultText() string {
 	if flag.DefaultText == "" && flag.FlagValue != nil {
-		return flag.FlagValue.GetInitialTextValue()
+		return DisplayValue(flag.FlagValue, flag.FlagValue.GetInitialTextValue())
 	}
 
 	return flag.DefaultText
@@ -230,3 +230,85 @@ func testGenericFlagApply[T cli.GenericType](t *testing.T, flag *cli.GenericFlag
 	assert.False(t, flag.Value().IsBoolFlag(), "IsBoolFlag()")
 	assert.True(t, flag.TakesValue(), "TakesValue()")
 }
+
+func TestGenericFlagSensitive(t *testing.T) {
+	t.Parallel()
+
+	const (
+		defaultSecret = "default-secret"
+		argSecret     = "arg-secret"
+		envSecret     = "env-secret"
+	)
+
+	newFlag := func(envs map[string]string) (*cli.GenericFlag[string], *libflag.FlagSet) {
+		flag := &cli.GenericFlag[string]{
+			Name:        "token",
+			EnvVars:     []string{"TOKEN"},
+			Destination: mockDestValue(defaultSecret),
+			Sensitive:   true,
+			Setter: func(val string) error {
+				if val == "invalid-"+envSecret {
+					return errors.New("token is malformed")
+				}
+
+				return nil
+			},
+		}
+		flag.LookupEnvFunc = mockLookupEnvFunc(envs, false)
+
+		flagSet := libflag.NewFlagSet("test-cmd", libflag.ContinueOnError)
+		flagSet.SetOutput(io.Discard)
+
+		return flag, flagSet
+	}
+
+	t.Run("arg", func(t *testing.T) {
+		t.Parallel()
+
+		flag, flagSet := newFlag(nil)
+
+		require.NoError(t, flag.Apply(flagSet))
+		assert.Equal(t, cli.SensitiveValueMask, flag.GetDefaultText())
+
+		require.NoError(t, flagSet.Parse([]string{"--token", argSecret}))
+
+		assert.Equal(t, argSecret, *flag.Destination)
+		assert.Equal(t, argSecret, flag.Value().Get())
+		assert.True(t, flag.Value().IsSet(), "IsSet()")
+		assert.True(t, flag.Value().IsSensitive(), "IsSensitive()")
+
+		for name, text := range map[string]string{
+			"GetValue()":       flag.GetValue(),
+			"GetDefaultText()": flag.GetDefaultText(),
+			"String()":         flag.String(),
+		} {
+			assert.NotContains(t, text, argSecret, name)
+			assert.NotContains(t, text, defaultSecret, name)
+		}
+
+		assert.Equal(t, cli.SensitiveValueMask, flag.GetValue())
+		assert.Contains(t, flag.String(), "--token")
+	})
+
+	t.Run("invalid env", func(t *testing.T) {
+		t.Parallel()
+
+		flag, flagSet := newFlag(map[string]string{"TOKEN": "invalid-" + envSecret})
+
+		err := flag.Apply(flagSet)
+		require.ErrorContains(t, err, "token is malformed")
+		assert.NotContains(t, err.Error(), envSecret)
+		assert.Contains(t, err.Error(), "TOKEN")
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		flag := &cli.GenericFlag[string]{Name: "token", Sensitive: true}
+		flag.LookupEnvFunc = mockLookupEnvFunc(nil, false)
+
+		require.NoError(t, flag.Apply(libflag.NewFlagSet("test-cmd", libflag.ContinueOnError)))
+		assert.Empty(t, flag.GetValue())
+		assert.Empty(t, flag.GetDefaultText())
+	})
+}
@@ -3,6 +3,7 @@ package exec
 
 import (
 	"context"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/gruntwork-io/terragrunt/internal/os/signal"
 	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"golang.org/x/term"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
@@ -27,6 +29,9 @@ type Cmd struct {
 
 	forwardSignalDelay time.Duration
 	interruptSignal    os.Signal
+	interruptMode      InterruptMode
+	killTimeout        time.Duration
+	processGroup       bool
 }
 
 // Command returns the `Cmd` struct to execute the named program with
@@ -57,6 +62,10 @@ func (cmd *Cmd) Configure(opts ...Option) {
 func (cmd *Cmd) Start() error {
 	// If we need to allocate a ptty for the command, route through the ptty routine.
 	// Otherwise, directly call the command.
+	if cmd.processGroup && !cmd.usePTY && !isTerminal(cmd.Stdin) {
+		setProcessGroup(cmd.Cmd)
+	}
+
 	if cmd.usePTY {
 		if err := runCommandWithPTY(cmd.logger, cmd.Cmd); err != nil {
 			return err
@@ -75,6 +84,7 @@ func (cmd *Cmd) Start() error {
 //     Thus we will send the signal to the executed command with a delay or immediately if Terragrunt receives this same signal again.
 //  2. If the context does not contain any causes, this means that there was some failure and we need to terminate all executed commands,
 //     in this situation we are sure that commands did not receive any signal, so we send them an interrupt signal immediately.
+//     If the kill timeout is set, the terminate signal is sent instead, and the command is killed if it does not exit within the timeout.
 func (cmd *Cmd) RegisterGracefullyShutdown(ctx context.Context) func() {
 	ctxShutdown, cancelShutdown := context.WithCancel(context.Background())
 
@@ -83,7 +93,20 @@ func (cmd *Cmd) RegisterGracefullyShutdown(ctx context.Context) func() {
 		case <-ctxShutdown.Done():
 		case <-ctx.Done():
 			if cause := new(signal.ContextCanceledError); errors.As(context.Cause(ctx), &cause) && cause.Signal != nil {
-				cmd.ForwardSignal(ctxShutdown, cause.Signal)
+				switch cmd.interruptMode {
+				case InterruptModeImmediate:
+					cmd.SendSignal(os.Kill)
+				case InterruptModeGraceful:
+					cmd.TerminateGracefully(ctxShutdown, cause.Signal)
+				default:
+					cmd.ForwardSignal(ctxShutdown, cause.Signal)
+				}
+
+				return
+			}
+
+			if cmd.killTimeout > 0 {
+				cmd.TerminateWithTimeout(ctxShutdown)
 
 				return
 			}
@@ -105,29 +128,65 @@ func (cmd *Cmd) ForwardSignal(ctx context.Context, sig os.Signal) {
 		cancelDelay()
 	}, sig)
 
-	if cmd.forwardSignalDelay > 0 {
+	// the command in its own process group does not receive the signal from the terminal, so there is nothing to wait for
+	delay := cmd.forwardSignalDelay
+	if cmd.inProcessGroup() {
+		delay = 0
+	}
+
+	if delay > 0 {
 		cmd.logger.Debugf("%s signal will be forwarded to %s with delay %s",
 			cases.Title(language.English).String(sig.String()),
 			cmd.filename,
-			cmd.forwardSignalDelay,
+			delay,
 		)
 	}
 
 	select {
 	case <-ctx.Done():
 		return
-	case <-time.After(cmd.forwardSignalDelay):
+	case <-time.After(delay):
 	case <-ctxDelay.Done():
 	}
 
 	cmd.SendSignal(sig)
 }
 
-// SendSignal sends the given `sig` to the executed command.
+// TerminateGracefully sends the terminate signal to the executed command and lets it shut down in its own time,
+// the command is killed only if the given `sig` is received again.
+func (cmd *Cmd) TerminateGracefully(ctx context.Context, sig os.Signal) {
+	signal.NotifierWithContext(ctx, func(_ os.Signal) {
+		cmd.SendSignal(os.Kill)
+	}, sig)
+
+	cmd.SendSignal(signal.TerminateSignal)
+}
+
+// TerminateWithTimeout sends the terminate signal to the executed command and kills it if it does not exit within the kill timeout.
+// The given `ctx` must be canceled once the command exits.
+func (cmd *Cmd) TerminateWithTimeout(ctx context.Context) {
+	cmd.SendSignal(signal.TerminateSignal)
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(cmd.killTimeout):
+		cmd.logger.Debugf("%s did not exit within %s after the terminate signal", cmd.filename, cmd.killTimeout)
+		cmd.SendSignal(os.Kill)
+	}
+}
+
+// SendSignal sends the given `sig` to the executed command, or to its whole process group if the command was started in its own group.
 func (cmd *Cmd) SendSignal(sig os.Signal) {
 	cmd.logger.Debugf("%s signal is forwarded to %s", cases.Title(language.English).String(sig.String()), cmd.filename)
 
-	if err := cmd.Process.Signal(sig); err != nil {
+	if err := cmd.signal(sig); err != nil {
 		cmd.logger.Errorf("Failed to forwarding signal %s to %s: %v", sig, cmd.filename, err)
 	}
 }
+
+// isTerminal returns true if the given reader is a terminal.
+func isTerminal(reader io.Reader) bool {
+	file, ok := reader.(*os.File)
+
+	return ok && term.IsTerminal(int(file.Fd()))
+}
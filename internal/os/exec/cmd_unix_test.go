@@ -4,13 +4,18 @@
 package exec_test
 
 import (
+	"context"
 	"errors"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"syscall"
 	"testing"
 	"time"
 
 	"github.com/gruntwork-io/terragrunt/internal/os/exec"
+	"github.com/gruntwork-io/terragrunt/internal/os/signal"
 	"github.com/gruntwork-io/terragrunt/util"
 
 	"github.com/stretchr/testify/assert"
@@ -112,3 +117,117 @@ func TestNewSignalsForwarderMultipleUnix(t *testing.T) {
 	assert.LessOrEqual(t, retCode, interrupts, "Subprocess received wrong number of signals")
 	assert.Equal(t, expectedInterrupts, retCode, "Subprocess didn't receive multiple signals")
 }
+
+func TestInterruptModeUnix(t *testing.T) {
+	t.Parallel()
+
+	const (
+		intExitCode  = 2
+		termExitCode = 3
+	)
+
+	testCases := []struct {
+		name             string
+		mode             exec.InterruptMode
+		expectedSignal   syscall.Signal
+		expectedExitCode int
+	}{
+		{
+			name:           "immediate",
+			mode:           exec.InterruptModeImmediate,
+			expectedSignal: syscall.SIGKILL,
+		},
+		{
+			name:             "graceful",
+			mode:             exec.InterruptModeGraceful,
+			expectedExitCode: termExitCode,
+		},
+		{
+			name:             "default",
+			mode:             exec.InterruptModeDefault,
+			expectedExitCode: intExitCode,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cmd := exec.Command("testdata/test_sigterm_exit_code.sh", strconv.Itoa(intExitCode), strconv.Itoa(termExitCode))
+			cmd.Configure(exec.WithInterruptMode(tc.mode))
+
+			ctx, cancel := context.WithCancelCause(context.Background())
+
+			require.NoError(t, cmd.Start())
+
+			cancelShutdown := cmd.RegisterGracefullyShutdown(ctx)
+			defer cancelShutdown()
+
+			time.Sleep(time.Second)
+			cancel(signal.NewContextCanceledError(syscall.SIGINT))
+
+			err := cmd.Wait()
+			require.Error(t, err)
+
+			status, ok := cmd.ProcessState.Sys().(syscall.WaitStatus)
+			require.True(t, ok)
+
+			if tc.expectedSignal != 0 {
+				assert.True(t, status.Signaled(), "Expected the process to be terminated by a signal")
+				assert.Equal(t, tc.expectedSignal, status.Signal())
+
+				return
+			}
+
+			assert.Equal(t, tc.expectedExitCode, status.ExitStatus())
+		})
+	}
+}
+
+func TestKillTimeoutUnix(t *testing.T) {
+	t.Parallel()
+
+	const killTimeout = 2 * time.Second
+
+	pidFile := filepath.Join(t.TempDir(), "helper.pid")
+
+	cmd := exec.Command("testdata/test_sigterm_ignore.sh", pidFile)
+	cmd.Configure(exec.WithKillTimeout(killTimeout), exec.WithProcessGroup(true))
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+
+	require.NoError(t, cmd.Start())
+
+	cancelShutdown := cmd.RegisterGracefullyShutdown(ctx)
+	defer cancelShutdown()
+
+	var helperPid int
+
+	require.Eventually(t, func() bool {
+		data, err := os.ReadFile(pidFile)
+		if err != nil {
+			return false
+		}
+
+		helperPid, err = strconv.Atoi(strings.TrimSpace(string(data)))
+
+		return err == nil
+	}, 5*time.Second, 100*time.Millisecond)
+
+	start := time.Now()
+	cancel(nil)
+
+	err := cmd.Wait()
+	require.Error(t, err)
+
+	assert.GreaterOrEqual(t, time.Since(start), killTimeout, "Expected the process to be killed after the kill timeout")
+
+	status, ok := cmd.ProcessState.Sys().(syscall.WaitStatus)
+	require.True(t, ok)
+	assert.True(t, status.Signaled(), "Expected the process to be terminated by a signal")
+	assert.Equal(t, syscall.SIGKILL, status.Signal())
+
+	assert.Eventually(t, func() bool {
+		return syscall.Kill(helperPid, 0) != nil
+	}, 5*time.Second, 100*time.Millisecond, "Expected the helper process in the process group to be killed")
+}
@@ -9,6 +9,21 @@ import (
 
 const envVarsListFormat = "%s=%s"
 
+// InterruptMode defines how the executed command is stopped when Terragrunt is interrupted by a signal.
+type InterruptMode string
+
+const (
+	// InterruptModeDefault forwards the received signal to the command with a delay.
+	InterruptModeDefault InterruptMode = ""
+	// InterruptModeImmediate kills the command immediately.
+	InterruptModeImmediate InterruptMode = "immediate"
+	// InterruptModeGraceful sends the terminate signal to the command and waits until it exits.
+	InterruptModeGraceful InterruptMode = "graceful"
+)
+
+// InterruptModes contains the interrupt modes that can be specified by the user.
+var InterruptModes = []InterruptMode{InterruptModeImmediate, InterruptModeGraceful} //nolint:gochecknoglobals
+
 // Option is type for passing options to the Cmd.
 type Option func(*Cmd)
 
@@ -39,3 +54,27 @@ func WithForwardSignalDelay(delay time.Duration) Option {
 		cmd.forwardSignalDelay = delay
 	}
 }
+
+// WithInterruptMode sets the way the Cmd is stopped when Terragrunt is interrupted by a signal.
+func WithInterruptMode(mode InterruptMode) Option {
+	return func(cmd *Cmd) {
+		cmd.interruptMode = mode
+	}
+}
+
+// WithKillTimeout sets the time the Cmd is given to exit after the terminate signal, when Terragrunt stops it on a failure,
+// before it is killed. Zero disables killing, an interrupt signal is sent instead and the Cmd may run indefinitely.
+func WithKillTimeout(timeout time.Duration) Option {
+	return func(cmd *Cmd) {
+		cmd.killTimeout = timeout
+	}
+}
+
+// WithProcessGroup starts the Cmd in its own process group on the platforms supporting it, so that the signals are sent
+// to the whole group, including the helper processes started by the Cmd. The group is not used if the Cmd runs in a pty
+// or reads from a terminal, since a process outside the terminal foreground process group cannot read from it.
+func WithProcessGroup(state bool) Option {
+	return func(cmd *Cmd) {
+		cmd.processGroup = state
+	}
+}
@@ -0,0 +1,33 @@
+//go:build !windows
+// +build !windows
+
+package exec
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup makes the command start in a new process group, with the group ID equal to its PID.
+func setProcessGroup(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = new(syscall.SysProcAttr)
+	}
+
+	cmd.SysProcAttr.Setpgid = true
+}
+
+// inProcessGroup returns true if the command was started in its own process group.
+func (cmd *Cmd) inProcessGroup() bool {
+	return cmd.SysProcAttr != nil && cmd.SysProcAttr.Setpgid && cmd.Process != nil
+}
+
+// signal sends the given `sig` to the process group of the command if it was started in its own group, otherwise to the command process.
+func (cmd *Cmd) signal(sig os.Signal) error {
+	if sysSig, ok := sig.(syscall.Signal); ok && cmd.inProcessGroup() {
+		return syscall.Kill(-cmd.Process.Pid, sysSig)
+	}
+
+	return cmd.Process.Signal(sig)
+}
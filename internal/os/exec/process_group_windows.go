@@ -0,0 +1,22 @@
+//go:build windows
+// +build windows
+
+package exec
+
+import (
+	"os"
+	"os/exec"
+)
+
+// setProcessGroup is a no-op, process groups are not supported on Windows.
+func setProcessGroup(_ *exec.Cmd) {}
+
+// inProcessGroup always returns false, process groups are not supported on Windows.
+func (cmd *Cmd) inProcessGroup() bool {
+	return false
+}
+
+// signal sends the given `sig` to the command process.
+func (cmd *Cmd) signal(sig os.Signal) error {
+	return cmd.Process.Signal(sig)
+}
@@ -0,0 +1,9 @@
+#!/bin/bash -e
+
+INT_EXIT_CODE=$1
+TERM_EXIT_CODE=$2
+
+trap "exit $INT_EXIT_CODE" INT
+trap "exit $TERM_EXIT_CODE" TERM
+
+while true; do sleep 0.1; done
@@ -0,0 +1,13 @@
+#!/bin/bash
+
+# Ignores SIGTERM, and if the pid file path is given, starts a helper process that ignores SIGTERM as well.
+PID_FILE=$1
+
+trap '' TERM
+
+if [ -n "$PID_FILE" ]; then
+        bash -c "trap '' TERM; while true; do sleep 0.1; done" &
+        echo $! > "$PID_FILE"
+fi
+
+while true; do sleep 0.1; done
@@ -11,5 +11,8 @@ import (
 // InterruptSignal is an interrupt signal.
 var InterruptSignal = syscall.SIGINT //nolint:gochecknoglobals
 
+// TerminateSignal is a signal requesting the process to terminate gracefully.
+var TerminateSignal = syscall.SIGTERM //nolint:gochecknoglobals
+
 // InterruptSignals contains a list of signals that are treated as interrupts.
 var InterruptSignals = []os.Signal{syscall.SIGTERM, syscall.SIGINT} //nolint:gochecknoglobals
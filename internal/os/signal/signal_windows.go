@@ -10,5 +10,8 @@ import (
 // InterruptSignal is an interrupt signal.
 var InterruptSignal os.Signal = nil
 
+// TerminateSignal is a signal requesting the process to terminate, Windows supports only killing the process.
+var TerminateSignal os.Signal = os.Kill
+
 // InterruptSignals contains a list of signals that are treated as interrupts.
 var InterruptSignals []os.Signal = []os.Signal{}
@@ -0,0 +1,22 @@
+package progress
+
+import "context"
+
+const (
+	emitterContextKey ctxKey = iota
+)
+
+type ctxKey byte
+
+func ContextWithEmitter(ctx context.Context, emitter *Emitter) context.Context {
+	return context.WithValue(ctx, emitterContextKey, emitter)
+}
+
+// EmitterFromContext returns the Emitter stored in the context, or nil, which is safe to use and discards events.
+func EmitterFromContext(ctx context.Context) *Emitter {
+	if val, ok := ctx.Value(emitterContextKey).(*Emitter); ok {
+		return val
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+// Package progress provides reporting of the command progress as machine-readable events.
+package progress
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// Phase is the stage of the command reported by an event.
+type Phase string
+
+const (
+	PhaseStart    Phase = "start"
+	PhaseProgress Phase = "progress"
+	PhaseDone     Phase = "done"
+)
+
+// Event is a single progress event, written as one JSON object per line.
+type Event struct {
+	Time    time.Time `json:"time"`
+	Command string    `json:"command"`
+	Phase   Phase     `json:"phase"`
+	Percent float64   `json:"percent"`
+	Current string    `json:"current,omitempty"`
+	Total   int       `json:"total,omitempty"`
+	Done    int       `json:"done"`
+	Error   string    `json:"error,omitempty"`
+}
+
+// Emitter writes progress events as newline-delimited JSON. A nil Emitter is valid and discards all events,
+// so callers do not need to check whether progress reporting is enabled.
+type Emitter struct {
+	writer  io.Writer
+	command string
+	total   int
+	done    int
+	mu      sync.Mutex
+}
+
+// NewEmitter returns a new Emitter that writes events of the given `command` to the writer `w`.
+func NewEmitter(w io.Writer, command string) *Emitter {
+	return &Emitter{
+		writer:  w,
+		command: command,
+	}
+}
+
+// Start emits the start event, `total` is the number of items expected to be processed, zero if not yet known.
+func (emitter *Emitter) Start(total int) {
+	if emitter == nil {
+		return
+	}
+
+	emitter.mu.Lock()
+	defer emitter.mu.Unlock()
+
+	emitter.total = total
+	emitter.emit(PhaseStart, "", nil)
+}
+
+// SetTotal sets the number of items expected to be processed, if it was not known at the start.
+func (emitter *Emitter) SetTotal(total int) {
+	if emitter == nil {
+		return
+	}
+
+	emitter.mu.Lock()
+	defer emitter.mu.Unlock()
+
+	emitter.total = total
+}
+
+// Progress emits the progress event for the processed `current` item.
+func (emitter *Emitter) Progress(current string) {
+	if emitter == nil {
+		return
+	}
+
+	emitter.mu.Lock()
+	defer emitter.mu.Unlock()
+
+	emitter.done++
+	emitter.emit(PhaseProgress, current, nil)
+}
+
+// Done emits the done event, with the error message if `err` is not nil.
+func (emitter *Emitter) Done(err error) {
+	if emitter == nil {
+		return
+	}
+
+	emitter.mu.Lock()
+	defer emitter.mu.Unlock()
+
+	if err == nil {
+		emitter.done = max(emitter.done, emitter.total)
+	}
+
+	emitter.emit(PhaseDone, "", err)
+}
+
+func (emitter *Emitter) emit(phase Phase, current string, err error) {
+	event := Event{
+		Time:    time.Now(),
+		Command: emitter.command,
+		Phase:   phase,
+		Percent: emitter.percent(),
+		Current: current,
+		Total:   emitter.total,
+		Done:    emitter.done,
+	}
+
+	if err != nil {
+		event.Error = err.Error()
+	}
+
+	// The events are informational, a failure to write them must not fail the command.
+	_ = json.NewEncoder(emitter.writer).Encode(event)
+}
+
+func (emitter *Emitter) percent() float64 {
+	if emitter.total <= 0 {
+		return 0
+	}
+
+	return min(float64(emitter.done)/float64(emitter.total)*100, 100) //nolint:mnd
+}
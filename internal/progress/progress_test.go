@@ -0,0 +1,106 @@
+package progress_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/progress"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmitter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		err            error
+		expectedEvents []progress.Event
+	}{
+		{
+			name: "success",
+			expectedEvents: []progress.Event{
+				{Command: "catalog", Phase: progress.PhaseStart, Percent: 0, Total: 2, Done: 0},
+				{Command: "catalog", Phase: progress.PhaseProgress, Percent: 50, Current: "repo-a", Total: 2, Done: 1},
+				{Command: "catalog", Phase: progress.PhaseProgress, Percent: 100, Current: "repo-b", Total: 2, Done: 2},
+				{Command: "catalog", Phase: progress.PhaseDone, Percent: 100, Total: 2, Done: 2},
+			},
+		},
+		{
+			name: "failure",
+			err:  errors.New("clone failed"),
+			expectedEvents: []progress.Event{
+				{Command: "catalog", Phase: progress.PhaseStart, Percent: 0, Total: 2, Done: 0},
+				{Command: "catalog", Phase: progress.PhaseProgress, Percent: 50, Current: "repo-a", Total: 2, Done: 1},
+				{Command: "catalog", Phase: progress.PhaseProgress, Percent: 100, Current: "repo-b", Total: 2, Done: 2},
+				{Command: "catalog", Phase: progress.PhaseDone, Percent: 100, Total: 2, Done: 2, Error: "clone failed"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			emitter := progress.NewEmitter(&buf, "catalog")
+			emitter.Start(2)
+			emitter.Progress("repo-a")
+			emitter.Progress("repo-b")
+			emitter.Done(tc.err)
+
+			var events []progress.Event
+
+			scanner := bufio.NewScanner(&buf)
+			for scanner.Scan() {
+				var event progress.Event
+
+				require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+				assert.False(t, event.Time.IsZero())
+
+				event.Time = event.Time.UTC()
+				events = append(events, event)
+			}
+
+			require.Len(t, events, len(tc.expectedEvents))
+
+			for i, expected := range tc.expectedEvents {
+				expected.Time = events[i].Time
+				assert.Equal(t, expected, events[i])
+			}
+		})
+	}
+}
+
+func TestEmitterFromContext(t *testing.T) {
+	t.Parallel()
+
+	emitter := progress.EmitterFromContext(context.Background())
+	assert.Nil(t, emitter)
+
+	// A nil emitter must discard the events without panicking.
+	emitter.Start(1)
+	emitter.SetTotal(1)
+	emitter.Progress("unit")
+	emitter.Done(nil)
+
+	var buf bytes.Buffer
+
+	emitter = progress.NewEmitter(&buf, "run")
+	ctx := progress.ContextWithEmitter(context.Background(), emitter)
+
+	progress.EmitterFromContext(ctx).SetTotal(4)
+	progress.EmitterFromContext(ctx).Progress("unit")
+
+	var event progress.Event
+
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &event))
+	assert.Equal(t, progress.PhaseProgress, event.Phase)
+	assert.InDelta(t, 25, event.Percent, 0)
+	assert.Equal(t, "unit", event.Current)
+}
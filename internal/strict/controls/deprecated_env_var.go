@@ -79,7 +79,7 @@ func (ctrl *DeprecatedEnvVar) Evaluate(ctx context.Context) error {
 			value = ctrl.deprecatedFlag.Value().String()
 		}
 
-		envName += "=" + value
+		envName += "=" + cli.DisplayValue(ctrl.newFlag.Value(), value)
 	}
 
 	if ctrl.Enabled {
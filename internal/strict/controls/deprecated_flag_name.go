@@ -97,7 +97,7 @@ func (ctrl *DeprecatedFlagName) Evaluate(ctx context.Context) error {
 				value = ctrl.deprecatedFlag.Value().String()
 			}
 
-			flagName += "=" + value
+			flagName += "=" + cli.DisplayValue(ctrl.newFlag.Value(), value)
 		}
 	}
 
@@ -39,6 +39,9 @@ func main() {
 // If there is an error, display it in the console and exit with a non-zero exit code. Otherwise, exit 0.
 func checkForErrorsAndExit(logger log.Logger, exitCode int) func(error) {
 	return func(err error) {
+		// log the summaries of the messages dropped by sampling, which would be lost on exit
+		logger.Formatter().Flush()
+
 		if err == nil {
 			os.Exit(exitCode)
 		} else {
@@ -50,6 +50,10 @@ const (
 
 	DefaultIAMAssumeRoleDuration = 3600
 
+	// DefaultKillTimeout is the time OpenTofu/Terraform is given to exit after SIGTERM before it is killed.
+	// Zero means OpenTofu/Terraform is never killed, it is sent an interrupt signal and waited for.
+	DefaultKillTimeout = 0
+
 	minCommandLength = 2
 
 	defaultExcludesFile = ".terragrunt-excludes"
@@ -103,6 +107,9 @@ type TerragruntOptions struct {
 	// Location of the terraform binary
 	TerraformPath string
 
+	// TerraformWrapper is the command and its arguments that the terraform binary is run through, e.g. `time -p`.
+	TerraformWrapper []string
+
 	// Current Terraform command being executed by Terragrunt
 	TerraformCommand string
 
@@ -139,6 +146,11 @@ type TerragruntOptions struct {
 	// Unlike `WorkingDir`, this path is the same for all dependencies and points to the root working directory specified in the CLI.
 	RootWorkingDir string
 
+	// TFWorkingDirOverride is the directory in which to run OpenTofu/Terraform instead of the unit directory or the directory
+	// the Terraform source is downloaded into. It applies only to the unit of `TerragruntConfigPath`, options cloned
+	// for other units, e.g. in `run --all` or to read dependency outputs, do not inherit it.
+	TFWorkingDirOverride string
+
 	// Logger is an interface for logging events.
 	Logger log.Logger `clone:"shadowcopy"`
 
@@ -196,6 +208,10 @@ type TerragruntOptions struct {
 	// If you want stderr to go somewhere other than os.stderr
 	ErrWriter io.Writer
 
+	// JSONWriter, if set, receives the JSON output of Terragrunt itself, such as the progress events and the unit results,
+	// while `Writer` is redirected to `ErrWriter`. See `ReserveWriterForJSON`.
+	JSONWriter io.Writer
+
 	// When searching the directory tree, this is the max folders to check before exiting with an error. This is
 	// exposed here primarily so we can set it to a low value at test time.
 	MaxFoldersToCheck int
@@ -290,6 +306,15 @@ type TerragruntOptions struct {
 	// Disable TF output formatting
 	ForwardTFStdout bool
 
+	// Emit the command progress as newline-delimited JSON events to stdout
+	ProgressJSON bool
+
+	// How the OpenTofu/Terraform process is stopped when Terragrunt is interrupted: "immediate" or "graceful"
+	InterruptMode string
+
+	// How long to wait for OpenTofu/Terraform to exit after SIGTERM, when Terragrunt stops it on cancellation, before killing it
+	KillTimeout time.Duration
+
 	// Fail execution if is required to create S3 bucket
 	FailIfBucketCreationRequired bool
 
@@ -299,6 +324,28 @@ type TerragruntOptions struct {
 	// Disables validation terraform command
 	DisableCommandValidation bool
 
+	// AllowedCommands extends the list of known tofu/terraform commands accepted by the command validation.
+	AllowedCommands []string
+
+	// PassthroughAll passes the args after `--` to tofu/terraform verbatim, without validating the command or normalizing its flags.
+	PassthroughAll bool
+
+	// EnvAllowlist, if not empty, limits the env vars inherited from the Terragrunt process that are passed to tofu/terraform.
+	EnvAllowlist []string
+
+	// Preflight, if set, checks that the tofu/terraform binary exists and is not too old before running the command.
+	Preflight bool
+
+	// CapturePlanJSON, if set, is the path the `show -json` output of the plan is written to after the `plan` command succeeds.
+	CapturePlanJSON string
+
+	// KeepPlanFile keeps the intermediate binary plan file created to capture the plan JSON.
+	KeepPlanFile bool
+
+	// Default arguments inserted into specific OpenTofu/Terraform commands, keyed by the command name.
+	// Arguments passed by the user take precedence over these defaults.
+	TerraformDefaultArgs map[string][]string
+
 	// Variables for usage in scaffolding.
 	ScaffoldVars []string
 
@@ -314,6 +361,15 @@ type TerragruntOptions struct {
 	// Path to folder of scaffold output
 	ScaffoldOutputFolder string
 
+	// Path to folder where the catalog writes the README files of the discovered modules instead of launching the UI.
+	CatalogDumpReadmesDir string
+
+	// CatalogDuplicateModules is the policy of how the catalog handles the modules resolving to the same URL.
+	CatalogDuplicateModules string
+
+	// CatalogContinueOnError makes the catalog skip the repositories that fail to clone instead of aborting.
+	CatalogContinueOnError bool
+
 	// Root directory for graph command.
 	GraphRoot string
 
@@ -405,6 +461,12 @@ type TerragruntOptions struct {
 
 	// Graph runs the provided OpenTofu/Terraform against the graph of dependencies for the unit in the current working directory.
 	Graph bool
+
+	// OutputJSON prints the results of the units as a JSON array to stdout once the run of the stack is finished.
+	OutputJSON bool
+
+	// TraceDeps logs the resolved dependencies of each unit and the final run order of the stack at debug level.
+	TraceDeps bool
 }
 
 // TerragruntOptionsFunc is a functional option type used to pass options in certain integration tests
@@ -478,7 +540,7 @@ func NewTerragruntOptionsWithWriters(stdout, stderr io.Writer) *TerragruntOption
 		NonInteractive:           false,
 		TerraformCliArgs:         []string{},
 		Logger: log.New(
-			log.WithOutput(stderr),
+			log.WithSyncOutput(stderr),
 			log.WithLevel(DefaultLogLevel),
 			log.WithFormatter(format.NewFormatter(format.NewPrettyFormatPlaceholders())),
 		),
@@ -496,6 +558,7 @@ func NewTerragruntOptionsWithWriters(stdout, stderr io.Writer) *TerragruntOption
 		AutoRetry:                      true,
 		RetryMaxAttempts:               DefaultRetryMaxAttempts,
 		RetrySleepInterval:             DefaultRetrySleepInterval,
+		KillTimeout:                    DefaultKillTimeout,
 		RetryableErrors:                cloner.Clone(DefaultRetryableErrors),
 		ExcludeDirs:                    []string{},
 		IncludeDirs:                    []string{},
@@ -599,6 +662,18 @@ func (opts *TerragruntOptions) Clone() *TerragruntOptions {
 	return newOpts
 }
 
+// ReserveWriterForJSON reserves `Writer`, usually stdout, for the JSON output of Terragrunt by moving it to `JSONWriter`
+// and redirecting the rest of the output, including the one of OpenTofu/Terraform, to `ErrWriter`, so that the JSON stream
+// can be parsed. It returns the writer for the JSON output and must be called before the options are cloned for the units.
+func (opts *TerragruntOptions) ReserveWriterForJSON() io.Writer {
+	if opts.JSONWriter == nil {
+		opts.JSONWriter = opts.Writer
+		opts.Writer = opts.ErrWriter
+	}
+
+	return opts.JSONWriter
+}
+
 // CloneWithConfigPath creates a copy of this TerragruntOptions, but with different values for the given variables. This is useful for
 // creating a TerragruntOptions that behaves the same way, but is used for a Terraform module in a different folder.
 func (opts *TerragruntOptions) CloneWithConfigPath(configPath string) (*TerragruntOptions, error) {
@@ -606,6 +681,10 @@ func (opts *TerragruntOptions) CloneWithConfigPath(configPath string) (*Terragru
 
 	workingDir := filepath.Dir(configPath)
 
+	if configPath != opts.TerragruntConfigPath {
+		newOpts.TFWorkingDirOverride = ""
+	}
+
 	newOpts.TerragruntConfigPath = configPath
 	newOpts.WorkingDir = workingDir
 	newOpts.Logger = newOpts.Logger.WithFields(log.Fields{
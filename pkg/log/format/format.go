@@ -17,6 +17,7 @@ const (
 	PrettyFormatName   = "pretty"
 	JSONFormatName     = "json"
 	KeyValueFormatName = "key-value"
+	LogfmtFormatName   = "logfmt"
 )
 
 func NewBareFormatPlaceholders() Placeholders {
@@ -141,12 +142,45 @@ func NewKeyValueFormatPlaceholders() Placeholders {
 	}
 }
 
+// NewLogfmtFormatPlaceholders returns the key-value format with values quoted where needed,
+// so that the output can be parsed by logfmt tools.
+func NewLogfmtFormatPlaceholders() Placeholders {
+	return Placeholders{
+		Time(
+			Prefix("time="),
+			TimeFormat(RFC3339),
+			Escape(LogfmtEscape),
+		),
+		Level(
+			Prefix(" level="),
+			Escape(LogfmtEscape),
+		),
+		Field(WorkDirKeyName,
+			Prefix(" prefix="),
+			PathFormat(ShortRelativePath),
+			Escape(LogfmtEscape),
+		),
+		Field(TFPathKeyName,
+			Prefix(" tf-path="),
+			PathFormat(FilenamePath),
+			Escape(LogfmtEscape),
+		),
+		Message(
+			Prefix(" msg="),
+			PathFormat(RelativePath),
+			Color(DisableColor),
+			Escape(LogfmtEscape),
+		),
+	}
+}
+
 func ParseFormat(str string) (Placeholders, error) {
 	var presets = map[string]func() Placeholders{
 		BareFormatName:     NewBareFormatPlaceholders,
 		PrettyFormatName:   NewPrettyFormatPlaceholders,
 		JSONFormatName:     NewJSONFormatPlaceholders,
 		KeyValueFormatName: NewKeyValueFormatPlaceholders,
+		LogfmtFormatName:   NewLogfmtFormatPlaceholders,
 	}
 
 	for name, formatFn := range presets {
@@ -3,6 +3,7 @@ package format
 import (
 	"bytes"
 	"sync"
+	"time"
 
 	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/gruntwork-io/terragrunt/pkg/log"
@@ -19,6 +20,7 @@ type Formatter struct {
 	disabledColors bool
 	disabledOutput bool
 	relativePather *options.RelativePather
+	sampler        *sampler
 	mu             sync.Mutex
 }
 
@@ -40,6 +42,15 @@ func (formatter *Formatter) Format(entry *log.Entry) ([]byte, error) {
 		buf = new(bytes.Buffer)
 	}
 
+	if formatter.sampler != nil {
+		if !formatter.sampler.sample(entry) {
+			return buf.Bytes(), nil
+		}
+	} else if _, ok := summaryEntrySampler(entry); ok {
+		// the summary of the messages sampled by another formatter, which were not dropped by this one
+		return buf.Bytes(), nil
+	}
+
 	str, err := formatter.placeholders.Format(&options.Data{
 		Entry:          entry,
 		BaseDir:        formatter.baseDir,
@@ -108,6 +119,27 @@ func (formatter *Formatter) SetCustomFormat(str string) error {
 	return nil
 }
 
+// SetPrefixWidth truncates the prefix field to the given max width with a middle ellipsis, and pads shorter prefixes to it,
+// so that the messages line up. It applies to the current format and must be called after the format is set.
+func (formatter *Formatter) SetPrefixWidth(width int) {
+	if ph := formatter.placeholders.Get(placeholders.WorkDirKeyName); ph != nil {
+		ph.Options().Merge(options.Truncate(width), options.Width(width))
+	}
+}
+
+// SetSampling limits identical messages, with the same level and fields, to `perMessage` within the given `window`, the rest are dropped.
+// The number of dropped messages is logged as a separate "(repeated N times)" message once the window ends.
+func (formatter *Formatter) SetSampling(perMessage int, window time.Duration) {
+	formatter.sampler = newSampler(perMessage, window)
+}
+
+// Flush logs the summaries of the messages dropped by sampling in the current windows, without waiting for them to end.
+func (formatter *Formatter) Flush() {
+	if formatter.sampler != nil {
+		formatter.sampler.flushAll()
+	}
+}
+
 // SetDisabledColors enables/disables log colors.
 func (formatter *Formatter) SetDisabledColors(val bool) {
 	formatter.disabledColors = val
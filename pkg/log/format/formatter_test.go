@@ -0,0 +1,202 @@
+package format_test
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/pkg/log/format"
+	"github.com/gruntwork-io/terragrunt/pkg/log/format/placeholders"
+	"github.com/stretchr/testify/assert"
+)
+
+// syncBuffer is a buffer safe for the concurrent writes of the sampling summaries.
+type syncBuffer struct {
+	buf bytes.Buffer
+	mu  sync.Mutex
+}
+
+func (buf *syncBuffer) Write(p []byte) (int, error) {
+	buf.mu.Lock()
+	defer buf.mu.Unlock()
+
+	return buf.buf.Write(p)
+}
+
+func (buf *syncBuffer) String() string {
+	buf.mu.Lock()
+	defer buf.mu.Unlock()
+
+	return buf.buf.String()
+}
+
+func TestFormatterSampling(t *testing.T) {
+	t.Parallel()
+
+	const window = 200 * time.Millisecond
+
+	var buf syncBuffer
+
+	formatter := format.NewFormatter(placeholders.Placeholders{
+		placeholders.Level(),
+		placeholders.PlainText(" "),
+		placeholders.Message(),
+	})
+	formatter.SetSampling(1, window)
+
+	logger := log.New(log.WithOutput(&buf), log.WithLevel(log.InfoLevel), log.WithFormatter(formatter))
+
+	var wg sync.WaitGroup
+
+	for range 100 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			logger.Warnf("Retrying module")
+		}()
+	}
+
+	wg.Wait()
+
+	logger.Infof("Retrying module")
+	logger.WithField(placeholders.WorkDirKeyName, "modules/vpc").Warnf("Retrying module")
+
+	// the summary is logged once the window expires, without waiting for the next message
+	time.Sleep(window + 100*time.Millisecond)
+
+	logger.Warnf("Retrying module")
+
+	expected := []string{
+		"warn Retrying module",
+		"info Retrying module",
+		"warn Retrying module",
+		"warn Retrying module (repeated 99 times)",
+		"warn Retrying module",
+	}
+
+	assert.Equal(t, expected, strings.Split(strings.TrimSpace(buf.String()), "\n"))
+}
+
+func TestFormatterSamplingPerMessage(t *testing.T) {
+	t.Parallel()
+
+	const window = 200 * time.Millisecond
+
+	var buf syncBuffer
+
+	formatter := format.NewFormatter(placeholders.Placeholders{
+		placeholders.Level(),
+		placeholders.PlainText(" "),
+		placeholders.Message(),
+	})
+	formatter.SetSampling(3, window)
+
+	logger := log.New(log.WithOutput(&buf), log.WithLevel(log.InfoLevel), log.WithFormatter(formatter))
+
+	for range 10 {
+		logger.Warnf("Retrying module")
+	}
+
+	time.Sleep(window + 100*time.Millisecond)
+
+	expected := []string{
+		"warn Retrying module",
+		"warn Retrying module",
+		"warn Retrying module",
+		"warn Retrying module (repeated 7 times)",
+	}
+
+	assert.Equal(t, expected, strings.Split(strings.TrimSpace(buf.String()), "\n"))
+}
+
+func TestFormatterSamplingFlush(t *testing.T) {
+	t.Parallel()
+
+	var buf syncBuffer
+
+	formatter := format.NewFormatter(placeholders.Placeholders{
+		placeholders.Level(),
+		placeholders.PlainText(" "),
+		placeholders.Message(),
+	})
+	formatter.SetSampling(1, time.Hour)
+
+	logger := log.New(log.WithOutput(&buf), log.WithLevel(log.InfoLevel), log.WithFormatter(formatter))
+
+	for range 5 {
+		logger.Warnf("Retrying module")
+		logger.WithField(placeholders.WorkDirKeyName, "modules/vpc").Warnf("Retrying module")
+	}
+
+	logger.Infof("Applying module")
+
+	// the summaries are logged on flush, long before the window ends
+	formatter.Flush()
+	formatter.Flush()
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+
+	assert.Equal(t, []string{"warn Retrying module", "warn Retrying module", "info Applying module"}, lines[:3])
+	assert.Equal(t, []string{"warn Retrying module (repeated 4 times)", "warn Retrying module (repeated 4 times)"}, lines[3:])
+}
+
+func TestFormatterLogfmt(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		msg      string
+		expected string
+	}{
+		{
+			"Running",
+			`level=info msg=Running`,
+		},
+		{
+			"Running module",
+			`level=info msg="Running module"`,
+		},
+		{
+			"key=value",
+			`level=info msg="key=value"`,
+		},
+		{
+			`say "hello"`,
+			`level=info msg="say \"hello\""`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.msg, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			formatter := format.NewFormatter(format.NewLogfmtFormatPlaceholders())
+			logger := log.New(log.WithOutput(&buf), log.WithLevel(log.InfoLevel), log.WithFormatter(formatter))
+
+			logger.Info(testCase.msg)
+
+			line := strings.TrimSpace(buf.String())
+			assert.Regexp(t, `^time=\S+ `, line)
+			assert.Equal(t, testCase.expected, line[strings.Index(line, " ")+1:])
+		})
+	}
+}
+
+func TestFormatterLogfmtField(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	formatter := format.NewFormatter(format.NewLogfmtFormatPlaceholders())
+	logger := log.New(log.WithOutput(&buf), log.WithLevel(log.InfoLevel), log.WithFormatter(formatter))
+
+	logger.WithField(placeholders.TFPathKeyName, "my tofu").Info("Running")
+
+	assert.Contains(t, buf.String(), ` tf-path="my tofu" msg=Running`)
+}
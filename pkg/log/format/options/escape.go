@@ -2,6 +2,8 @@ package options
 
 import (
 	"encoding/json"
+	"strconv"
+	"strings"
 
 	"github.com/gruntwork-io/terragrunt/internal/errors"
 )
@@ -12,10 +14,12 @@ const EscapeOptionName = "escape"
 const (
 	NoneEscape EscapeValue = iota
 	JSONEscape
+	LogfmtEscape
 )
 
 var escapeList = NewMapValue(map[EscapeValue]string{ //nolint:gochecknoglobals
-	JSONEscape: "json",
+	JSONEscape:   "json",
+	LogfmtEscape: "logfmt",
 })
 
 type EscapeValue byte
@@ -26,7 +30,11 @@ type EscapeOption struct {
 
 // Format implements `Option` interface.
 func (option *EscapeOption) Format(_ *Data, val any) (any, error) {
-	if option.value.Get() != JSONEscape {
+	switch option.value.Get() {
+	case JSONEscape:
+	case LogfmtEscape:
+		return logfmtEscape(val), nil
+	default:
 		return val, nil
 	}
 
@@ -39,6 +47,23 @@ func (option *EscapeOption) Format(_ *Data, val any) (any, error) {
 	return string(jsonStr[1 : len(jsonStr)-1]), nil
 }
 
+// logfmtEscape quotes the string value if it is empty or contains spaces, quotes, equals signs or control characters,
+// so that it can be used as a value of a logfmt key-value pair.
+func logfmtEscape(val any) any {
+	str, ok := val.(string)
+	if !ok {
+		return val
+	}
+
+	if str != "" && !strings.ContainsFunc(str, func(r rune) bool {
+		return r <= ' ' || r == '=' || r == '"' || r == '\\' || r == 0x7f
+	}) {
+		return str
+	}
+
+	return strconv.Quote(str)
+}
+
 // Escape creates the option to escape text.
 func Escape(val EscapeValue) Option {
 	return &EscapeOption{
@@ -0,0 +1,47 @@
+package options
+
+import (
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+// TruncateOptionName is the option name.
+const TruncateOptionName = "truncate"
+
+const truncateEllipsis = "…"
+
+type TruncateOption struct {
+	*CommonOption[int]
+}
+
+// Format implements `Option` interface.
+// If the text is longer than the max width, the middle of the text is replaced with an ellipsis, e.g. `modules/…/vpc`.
+// ANSI color sequences are not counted toward the width, and are removed if the text is truncated.
+func (option *TruncateOption) Format(_ *Data, val any) (any, error) {
+	str := toString(val)
+
+	width := option.value.Get()
+	if width <= 0 {
+		return str, nil
+	}
+
+	runes := []rune(log.RemoveAllASCISeq(str))
+	if len(runes) <= width {
+		return str, nil
+	}
+
+	if width == 1 {
+		return truncateEllipsis, nil
+	}
+
+	tailLen := (width - 1) / 2 //nolint:mnd
+	headLen := width - 1 - tailLen
+
+	return string(runes[:headLen]) + truncateEllipsis + string(runes[len(runes)-tailLen:]), nil
+}
+
+// Truncate creates the option to truncate the text to the given max width, keeping its start and end.
+func Truncate(val int) Option {
+	return &TruncateOption{
+		CommonOption: NewCommonOption(TruncateOptionName, NewIntValue(val)),
+	}
+}
@@ -0,0 +1,67 @@
+package options_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/pkg/log/format/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTruncateFormat(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		width    int
+		value    string
+		expected string
+	}{
+		{
+			name:     "disabled",
+			width:    0,
+			value:    "modules/network/aws/vpc",
+			expected: "modules/network/aws/vpc",
+		},
+		{
+			name:     "at boundary",
+			width:    23,
+			value:    "modules/network/aws/vpc",
+			expected: "modules/network/aws/vpc",
+		},
+		{
+			name:     "one over boundary",
+			width:    22,
+			value:    "modules/network/aws/vpc",
+			expected: "modules/net…rk/aws/vpc",
+		},
+		{
+			name:     "middle ellipsis",
+			width:    13,
+			value:    "modules/network/aws/vpc",
+			expected: "module…ws/vpc",
+		},
+		{
+			name:     "ansi codes are not counted",
+			width:    11,
+			value:    "\x1b[36mmodules/vpc\x1b[0m",
+			expected: "\x1b[36mmodules/vpc\x1b[0m",
+		},
+		{
+			name:     "ansi codes are removed when truncated",
+			width:    10,
+			value:    "\x1b[36mmodules/vpc\x1b[0m",
+			expected: "modul…/vpc",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := options.Truncate(tc.width).Format(nil, tc.value)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package options
 
 import (
 	"strings"
+	"unicode/utf8"
 
 	"github.com/gruntwork-io/terragrunt/pkg/log"
 )
@@ -22,10 +23,10 @@ func (option *WidthOption) Format(_ *Data, val any) (any, error) {
 		return str, nil
 	}
 
-	strLen := len(log.RemoveAllASCISeq(str))
+	strLen := utf8.RuneCountInString(log.RemoveAllASCISeq(str))
 
 	if width < strLen {
-		return str[:width], nil
+		return string([]rune(str)[:width]), nil
 	}
 
 	return str + strings.Repeat(" ", width-strLen), nil
@@ -10,6 +10,7 @@ func WithCommonOptions(opts ...options.Option) options.Options {
 		options.Content(""),
 		options.Escape(options.NoneEscape),
 		options.Case(options.NoneCase),
+		options.Truncate(0),
 		options.Width(0),
 		options.Align(options.NoneAlign),
 		options.Prefix(""),
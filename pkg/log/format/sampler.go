@@ -0,0 +1,165 @@
+package format
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/sirupsen/logrus"
+)
+
+// samplerMaxEntries is the number of tracked messages above which expired ones are pruned.
+const samplerMaxEntries = 1000
+
+// samplerSummaryKey is the context key of the summary entries, its value is the sampler that logged the summary.
+type samplerSummaryKey struct{}
+
+// sampler limits how many identical messages are logged within a time window.
+type sampler struct {
+	entries    map[samplerKey]*sampledEntry
+	now        func() time.Time
+	perMessage int
+	window     time.Duration
+	mu         sync.Mutex
+}
+
+// samplerKey identifies identical entries. The fields are part of the key, so the same message logged by different units,
+// which differ in the prefix field, is sampled separately and the repeats of one unit never hide the messages of another.
+type samplerKey struct {
+	msg    string
+	fields string
+	level  log.Level
+}
+
+type sampledEntry struct {
+	start      time.Time
+	logger     *logrus.Logger
+	fields     logrus.Fields
+	count      int
+	suppressed int
+}
+
+func newSampler(perMessage int, window time.Duration) *sampler {
+	return &sampler{
+		entries:    make(map[samplerKey]*sampledEntry),
+		now:        time.Now,
+		perMessage: max(perMessage, 1),
+		window:     window,
+	}
+}
+
+// sample returns false if the entry should be suppressed. Identical entries are the ones with the same level, message and fields.
+// Once the window of the suppressed entries expires, the number of them is logged in a separate summary entry.
+func (sampler *sampler) sample(entry *log.Entry) bool {
+	if summarySampler, ok := summaryEntrySampler(entry); ok {
+		// the summary is logged through the logger, so the other formatters receive it as well
+		return summarySampler == sampler
+	}
+
+	sampler.mu.Lock()
+	defer sampler.mu.Unlock()
+
+	now := sampler.now()
+	key := samplerKey{
+		level: entry.Level,
+		msg:   entry.Message,
+		// maps are printed with sorted keys
+		fields: fmt.Sprint(entry.Fields),
+	}
+
+	sampled, ok := sampler.entries[key]
+	if !ok || now.Sub(sampled.start) > sampler.window {
+		sampler.prune(now)
+		sampler.entries[key] = &sampledEntry{start: now, count: 1}
+
+		return true
+	}
+
+	sampled.count++
+
+	if sampled.count <= sampler.perMessage {
+		return true
+	}
+
+	sampled.suppressed++
+
+	if sampled.suppressed == 1 && entry.Entry != nil && entry.Logger != nil {
+		sampled.logger = entry.Logger
+		sampled.fields = logrus.Fields(entry.Fields)
+
+		time.AfterFunc(sampled.start.Add(sampler.window).Sub(now), func() {
+			sampler.flush(key, sampled)
+		})
+	}
+
+	return false
+}
+
+// flush logs the summary of the suppressed entries of the expired window.
+func (sampler *sampler) flush(key samplerKey, sampled *sampledEntry) {
+	sampler.mu.Lock()
+
+	suppressed := sampled.suppressed
+	sampled.suppressed = 0
+
+	if sampler.entries[key] == sampled {
+		delete(sampler.entries, key)
+	}
+
+	sampler.mu.Unlock()
+
+	if suppressed == 0 {
+		return
+	}
+
+	ctx := context.WithValue(context.Background(), samplerSummaryKey{}, sampler)
+
+	logrus.NewEntry(sampled.logger).WithContext(ctx).WithFields(sampled.fields).
+		Log(key.level.ToLogrusLevel(), fmt.Sprintf("%s (repeated %d times)", key.msg, suppressed))
+}
+
+// flushAll logs the summaries of the suppressed entries of all the windows, including the ones that have not expired yet.
+// The timers of the flushed windows find nothing to report when they fire.
+func (sampler *sampler) flushAll() {
+	sampler.mu.Lock()
+
+	pending := make(map[samplerKey]*sampledEntry)
+
+	for key, sampled := range sampler.entries {
+		if sampled.suppressed > 0 {
+			pending[key] = sampled
+		}
+	}
+
+	sampler.mu.Unlock()
+
+	for key, sampled := range pending {
+		sampler.flush(key, sampled)
+	}
+}
+
+// prune removes the entries of expired windows that have nothing to report, once there are too many of them.
+func (sampler *sampler) prune(now time.Time) {
+	if len(sampler.entries) < samplerMaxEntries {
+		return
+	}
+
+	for key, entry := range sampler.entries {
+		if entry.suppressed == 0 && now.Sub(entry.start) > sampler.window {
+			delete(sampler.entries, key)
+		}
+	}
+}
+
+// summaryEntrySampler returns the sampler that logged the given summary entry, and false if the entry is not a summary.
+func summaryEntrySampler(entry *log.Entry) (*sampler, bool) {
+	if entry.Entry == nil || entry.Context == nil {
+		return nil, false
+	}
+
+	sampler, ok := entry.Context.Value(samplerSummaryKey{}).(*sampler)
+
+	return sampler, ok
+}
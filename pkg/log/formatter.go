@@ -1,6 +1,8 @@
 package log
 
 import (
+	"time"
+
 	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/sirupsen/logrus"
 )
@@ -23,6 +25,12 @@ type Formatter interface {
 	SetFormat(str string) error
 	// SetCustomFormat parses and sets custom log format.
 	SetCustomFormat(str string) error
+	// SetPrefixWidth truncates and pads the prefix field to the given width.
+	SetPrefixWidth(width int)
+	// SetSampling limits identical messages to `perMessage` within the given `window`.
+	SetSampling(perMessage int, window time.Duration)
+	// Flush logs the pending summaries of the sampled messages, it is called before the program exits.
+	Flush()
 
 	// Format takes an `Entry`. It exposes all the fields, including the default ones:
 	//
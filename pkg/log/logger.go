@@ -123,12 +123,15 @@ type Logger interface {
 type logger struct {
 	*logrus.Entry
 	formatter Formatter
+	// level is the level of the primary output, the underlying logrus logger may have a more verbose level for the sinks.
+	level Level
 }
 
 // New returns a new Logger instance.
 func New(opts ...Option) Logger {
 	logger := &logger{
 		Entry: logrus.NewEntry(logrus.New()),
+		level: InfoLevel,
 	}
 	logger.SetOptions(opts...)
 
@@ -155,6 +158,7 @@ func (logger *logger) SetOptions(opts ...Option) {
 func (logger *logger) SetFormatter(formatter Formatter) {
 	logger.formatter = formatter
 	logger.Logger.SetFormatter(&fromLogrusFormatter{Formatter: formatter})
+	logger.applyLevel()
 }
 
 // SetFormatter returns the logger formatter.
@@ -176,7 +180,7 @@ func (logger *logger) WithOptions(opts ...Option) Logger {
 
 // Level returns log level.
 func (logger *logger) Level() Level {
-	return FromLogrusLevel(logger.Logger.Level)
+	return logger.level
 }
 
 // SetLevel parses and sets log level.
@@ -186,7 +190,8 @@ func (logger *logger) SetLevel(str string) error {
 		return err
 	}
 
-	logger.Logger.SetLevel(level.ToLogrusLevel())
+	logger.level = level
+	logger.applyLevel()
 
 	return nil
 }
@@ -2,6 +2,7 @@ package log
 
 import (
 	"io"
+	"sync"
 
 	"github.com/sirupsen/logrus"
 )
@@ -12,7 +13,8 @@ type Option func(logger *logger)
 // WithLevel sets the logger level.
 func WithLevel(level Level) Option {
 	return func(logger *logger) {
-		logger.Logger.SetLevel(level.ToLogrusLevel())
+		logger.level = level
+		logger.applyLevel()
 	}
 }
 
@@ -23,6 +25,33 @@ func WithOutput(output io.Writer) Option {
 	}
 }
 
+// WithSyncOutput sets the logger output wrapped in a mutex, so that each record is written atomically even if
+// the output is shared by loggers writing concurrently, e.g. by the loggers of units in `run --all`.
+// Cloned loggers share the same mutex with their parent.
+func WithSyncOutput(output io.Writer) Option {
+	return func(logger *logger) {
+		if _, ok := output.(*syncWriter); !ok {
+			output = &syncWriter{Writer: output}
+		}
+
+		logger.Logger.SetOutput(output)
+	}
+}
+
+// syncWriter serializes writes to the underlying writer.
+type syncWriter struct {
+	io.Writer
+	mu sync.Mutex
+}
+
+// Write implements `io.Writer` interface.
+func (writer *syncWriter) Write(p []byte) (int, error) {
+	writer.mu.Lock()
+	defer writer.mu.Unlock()
+
+	return writer.Writer.Write(p)
+}
+
 // WithFormatter sets the logger formatter.
 func WithFormatter(formatter Formatter) Option {
 	return func(logger *logger) {
@@ -30,6 +59,16 @@ func WithFormatter(formatter Formatter) Option {
 	}
 }
 
+// WithSink adds an extra output receiving the entries at the given `level` and the less verbose ones, formatted with the given `formatter`,
+// regardless of the logger level, e.g. to send warnings to a central collector and debug entries to a local file.
+// The primary output keeps the logger level. Cloned loggers share the sinks with their parent.
+func WithSink(output io.Writer, level Level, formatter Formatter) Option {
+	return func(logger *logger) {
+		logger.Logger.AddHook(newSinkHook(output, level, formatter))
+		logger.applyLevel()
+	}
+}
+
 // WithHooks adds hooks to the logger hooks.
 func WithHooks(hooks ...logrus.Hook) Option {
 	return func(logger *logger) {
@@ -0,0 +1,117 @@
+package log_test
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/pkg/log/format"
+	"github.com/gruntwork-io/terragrunt/pkg/log/format/placeholders"
+	"github.com/stretchr/testify/assert"
+)
+
+// unsafeWriter is not safe for concurrent use, and counts the writes that overlapped.
+type unsafeWriter struct {
+	buf      bytes.Buffer
+	inFlight atomic.Int32
+	overlaps atomic.Int32
+}
+
+func (writer *unsafeWriter) Write(p []byte) (int, error) {
+	if writer.inFlight.Add(1) > 1 {
+		writer.overlaps.Add(1)
+	}
+	defer writer.inFlight.Add(-1)
+
+	// Write byte by byte to widen the window for interleaving.
+	for _, b := range p {
+		writer.buf.WriteByte(b)
+		runtime.Gosched()
+	}
+
+	return len(p), nil
+}
+
+func TestWithSyncOutput(t *testing.T) {
+	t.Parallel()
+
+	const (
+		goroutines = 50
+		records    = 20
+	)
+
+	output := new(unsafeWriter)
+	formatter := format.NewFormatter(placeholders.Placeholders{placeholders.Message()})
+	logger := log.New(log.WithSyncOutput(output), log.WithLevel(log.InfoLevel), log.WithFormatter(formatter))
+
+	var (
+		wg    sync.WaitGroup
+		start = make(chan struct{})
+	)
+
+	for i := range goroutines {
+		wg.Add(1)
+
+		// Each unit of `run --all` logs with its own clone of the logger.
+		unitLogger := logger.WithOptions(log.WithLevel(log.InfoLevel))
+
+		go func() {
+			defer wg.Done()
+
+			<-start
+
+			for j := range records {
+				unitLogger.Infof("unit-%d record-%d", i, j)
+			}
+		}()
+	}
+
+	close(start)
+	wg.Wait()
+
+	assert.Zero(t, output.overlaps.Load())
+
+	lines := strings.Split(strings.TrimSpace(output.buf.String()), "\n")
+	assert.Len(t, lines, goroutines*records)
+
+	for _, line := range lines {
+		var unit, record int
+
+		_, err := fmt.Sscanf(line, "unit-%d record-%d", &unit, &record)
+		assert.NoError(t, err, "partial line %q", line)
+	}
+}
+
+func TestWithSink(t *testing.T) {
+	t.Parallel()
+
+	newFormatter := func() log.Formatter {
+		return format.NewFormatter(placeholders.Placeholders{placeholders.Message()})
+	}
+
+	var output, warnSink, debugSink bytes.Buffer
+
+	logger := log.New(
+		log.WithOutput(&output),
+		log.WithLevel(log.InfoLevel),
+		log.WithFormatter(newFormatter()),
+		log.WithSink(&warnSink, log.WarnLevel, newFormatter()),
+		log.WithSink(&debugSink, log.DebugLevel, newFormatter()),
+	)
+
+	logger.Debugf("debug line")
+	logger.Infof("info line")
+	logger.WithField("unit", "app").Warnf("warn line")
+	logger.Tracef("trace line")
+
+	assert.Equal(t, log.InfoLevel, logger.Level())
+
+	assert.Equal(t, "info line\nwarn line\n", output.String())
+	assert.Equal(t, "warn line\n", warnSink.String())
+	assert.Equal(t, "debug line\ninfo line\nwarn line\n", debugSink.String())
+}
@@ -0,0 +1,93 @@
+package log
+
+import (
+	"io"
+
+	"github.com/sirupsen/logrus"
+)
+
+// sinkHook writes the entries of its levels to an extra output, formatted with its own formatter.
+type sinkHook struct {
+	output    io.Writer
+	formatter *fromLogrusFormatter
+	level     Level
+}
+
+func newSinkHook(output io.Writer, level Level, formatter Formatter) *sinkHook {
+	if _, ok := output.(*syncWriter); !ok {
+		output = &syncWriter{Writer: output}
+	}
+
+	return &sinkHook{
+		output:    output,
+		formatter: &fromLogrusFormatter{Formatter: formatter},
+		level:     level,
+	}
+}
+
+// Levels implements logrus.Hook.Levels()
+func (hook *sinkHook) Levels() []logrus.Level {
+	var levels Levels
+
+	for _, level := range AllLevels {
+		if level <= hook.level {
+			levels = append(levels, level)
+		}
+	}
+
+	return levels.ToLogrusLevels()
+}
+
+// Fire implements logrus.Hook.Fire()
+func (hook *sinkHook) Fire(entry *logrus.Entry) error {
+	b, err := hook.formatter.Format(entry)
+	if err != nil {
+		return err
+	}
+
+	_, err = hook.output.Write(b)
+
+	return err
+}
+
+// levelFormatter drops the entries more verbose than its level. It is used to keep the primary output at the logger level,
+// while the level of the underlying logrus logger is lowered so that the entries reach the more verbose sinks.
+type levelFormatter struct {
+	logrus.Formatter
+	level logrus.Level
+}
+
+// Format implements logrus.Formatter
+func (formatter *levelFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	if formatter.level >= entry.Level {
+		return formatter.Formatter.Format(entry)
+	}
+
+	return []byte(""), nil
+}
+
+// applyLevel sets the level of the underlying logrus logger to the most verbose of the logger level and the sink levels,
+// and makes the primary output drop the entries more verbose than the logger level.
+func (logger *logger) applyLevel() {
+	formatter := logger.Logger.Formatter
+	if leveled, ok := formatter.(*levelFormatter); ok {
+		formatter = leveled.Formatter
+	}
+
+	level := logger.level
+
+	for _, hooks := range logger.Logger.Hooks {
+		for _, hook := range hooks {
+			if sink, ok := hook.(*sinkHook); ok && sink.level > level {
+				level = sink.level
+			}
+		}
+	}
+
+	if level > logger.level {
+		formatter = &levelFormatter{Formatter: formatter, level: logger.level.ToLogrusLevel()}
+	}
+
+	logger.Logger.SetLevel(level.ToLogrusLevel())
+	logger.Logger.SetFormatter(formatter)
+}
@@ -1,6 +1,10 @@
 package writer
 
-import "github.com/gruntwork-io/terragrunt/pkg/log"
+import (
+	"regexp"
+
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
 
 // Option is a function to set options for Writer.
 type Option func(writer *Writer)
@@ -32,3 +36,22 @@ func WithParseFunc(fn WriterParseFunc) Option {
 		writer.parseFunc = fn
 	}
 }
+
+// WithRedactFunc sets the func to redact sensitive values in each line of the received text before it is logged.
+func WithRedactFunc(fn WriterRedactFunc) Option {
+	return func(writer *Writer) {
+		writer.redactFunc = fn
+	}
+}
+
+// WithRedactPatterns configures Writer to replace the matches of the given patterns with `RedactedValueMask`
+// in each line of the received text before it is logged.
+func WithRedactPatterns(patterns ...*regexp.Regexp) Option {
+	return WithRedactFunc(func(line string) string {
+		for _, pattern := range patterns {
+			line = pattern.ReplaceAllString(line, RedactedValueMask)
+		}
+
+		return line
+	})
+}
@@ -8,15 +8,22 @@ import (
 	"github.com/gruntwork-io/terragrunt/pkg/log"
 )
 
+// RedactedValueMask replaces the sensitive values matched by the patterns set with `WithRedactPatterns`.
+const RedactedValueMask = "***"
+
 // WriterParseFunc is a function used to parse records to extract the time and level from them.
 type WriterParseFunc func(str string) (msg string, time *time.Time, level *log.Level, err error)
 
+// WriterRedactFunc is a function used to redact sensitive values in a line of the message before it is logged.
+type WriterRedactFunc func(line string) string
+
 // Writer redirects Write requests to configured logger and level
 type Writer struct {
 	logger       log.Logger
 	defaultLevel log.Level
 	msgSeparator string
 	parseFunc    WriterParseFunc
+	redactFunc   WriterRedactFunc
 }
 
 // New returns a new Writer instance with fields assigned to default values.
@@ -59,6 +66,10 @@ func (writer *Writer) Write(p []byte) (n int, err error) {
 			return 0, err
 		}
 
+		if writer.redactFunc != nil {
+			msg = writer.redact(msg)
+		}
+
 		// Reset ANSI styles at the end of a line so that the new line does not inherit them
 		msg = log.ResetASCISeq(msg)
 
@@ -77,3 +88,15 @@ func (writer *Writer) Write(p []byte) (n int, err error) {
 
 	return len(p), nil
 }
+
+// redact applies the redact func to each line of the given message, so that a multiline message is redacted the same way
+// as if it was split into separate records.
+func (writer *Writer) redact(msg string) string {
+	lines := strings.Split(msg, "\n")
+
+	for i, line := range lines {
+		lines[i] = writer.redactFunc(line)
+	}
+
+	return strings.Join(lines, "\n")
+}
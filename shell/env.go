@@ -0,0 +1,33 @@
+package shell
+
+import (
+	"os"
+	"slices"
+	"strings"
+)
+
+// requiredEnvVars are always passed to tofu/terraform, even if they are not in `--env-allowlist`,
+// since tofu/terraform and its providers cannot run without them.
+var requiredEnvVars = []string{"PATH", "HOME", "TMPDIR", "TMP", "TEMP", "USERPROFILE", "SYSTEMROOT"} //nolint:gochecknoglobals
+
+// allowedEnv returns the env vars of `env` allowed by `allowlist`. The env vars set by Terragrunt, e.g. inputs passed as `TF_VAR_`
+// env vars or credentials of the assumed role, are always allowed. They are recognized by not being inherited as is from the
+// Terragrunt process environment. If `allowlist` is empty, `env` is returned unchanged.
+func allowedEnv(env map[string]string, allowlist []string) map[string]string {
+	if len(allowlist) == 0 {
+		return env
+	}
+
+	allowed := make(map[string]string, len(env))
+
+	for key, val := range env {
+		if processVal, ok := os.LookupEnv(key); ok && processVal == val &&
+			!slices.Contains(allowlist, key) && !slices.ContainsFunc(requiredEnvVars, func(name string) bool { return strings.EqualFold(name, key) }) {
+			continue
+		}
+
+		allowed[key] = val
+	}
+
+	return allowed
+}
@@ -3,7 +3,9 @@ package shell
 import (
 	"bytes"
 	"context"
+	"maps"
 	"net/url"
+	"slices"
 	"strings"
 
 	"github.com/gruntwork-io/terragrunt/internal/cache"
@@ -15,6 +17,12 @@ import (
 const (
 	gitPrefix = "git::"
 	refsTags  = "refs/tags/"
+	refsHeads = "refs/heads/"
+
+	symrefPrefix = "ref: "
+	peeledSuffix = "^{}"
+
+	notGitRepoMsg = "not a git repository"
 
 	tagSplitPart = 2
 )
@@ -53,6 +61,40 @@ func GitTopLevelDir(ctx context.Context, terragruntOptions *options.TerragruntOp
 	return cmdOutput, nil
 }
 
+// GitIsInsideWorkTree returns true if the passed directory is inside a git work tree.
+func GitIsInsideWorkTree(ctx context.Context, terragruntOptions *options.TerragruntOptions, path string) (bool, error) {
+	stdout := bytes.Buffer{}
+	stderr := bytes.Buffer{}
+
+	opts, err := options.NewTerragruntOptionsWithConfigPath(path)
+	if err != nil {
+		return false, err
+	}
+
+	opts.Logger = terragruntOptions.Logger.Clone()
+	opts.Env = maps.Clone(terragruntOptions.Env)
+	opts.Writer = &stdout
+	opts.ErrWriter = &stderr
+
+	if opts.Env == nil {
+		opts.Env = make(map[string]string)
+	}
+
+	// the error message checked below is translated in other locales
+	opts.Env["LC_ALL"] = "C"
+
+	cmd, err := RunCommandWithOutput(ctx, opts, path, true, false, "git", "rev-parse", "--is-inside-work-tree")
+	if err != nil {
+		if cmd != nil && strings.Contains(cmd.Stderr.String(), notGitRepoMsg) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return strings.TrimSpace(cmd.Stdout.String()) == "true", nil
+}
+
 // GitRepoTags fetches git repository tags from passed url.
 func GitRepoTags(ctx context.Context, opts *options.TerragruntOptions, gitRepo *url.URL) ([]string, error) {
 	repoPath := gitRepo.String()
@@ -79,16 +121,126 @@ func GitRepoTags(ctx context.Context, opts *options.TerragruntOptions, gitRepo *
 
 	var tags []string
 
-	tagLines := strings.Split(output.Stdout.String(), "\n")
+	for _, ref := range parseLsRemoteOutput(output.Stdout.String()) {
+		tags = append(tags, ref.Name)
+	}
+
+	return tags, nil
+}
+
+// GitRef is a reference of a remote git repository.
+type GitRef struct {
+	// Name is the full name of the reference, e.g. `refs/tags/v1.0.0`.
+	Name string
+	// Hash is the commit hash the reference points to, annotated tags are resolved to the tagged commit.
+	Hash string
+	// SymrefTarget is the full name of the reference a symbolic reference points to, e.g. `refs/heads/main` for `HEAD`.
+	// It is only set by `GitLsRemote`, for the symbolic references.
+	SymrefTarget string
+}
+
+// GitRemoteRefs lists the branches and tags of the git repository from passed url matching the given glob `pattern`,
+// e.g. `refs/tags/v*`, sorted by name. All branches and tags are listed if the pattern is empty.
+func GitRemoteRefs(ctx context.Context, opts *options.TerragruntOptions, gitRepo *url.URL, pattern string) ([]GitRef, error) {
+	repoPath := gitRepo.String()
+	// remove git:: part if present
+	repoPath = strings.TrimPrefix(repoPath, gitPrefix)
+
+	args := []string{"ls-remote", "--heads", "--tags", repoPath}
+	if pattern != "" {
+		args = append(args, pattern)
+	}
+
+	output, err := RunCommandWithOutput(ctx, opts, opts.WorkingDir, true, false, "git", args...)
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	var (
+		refs    []GitRef
+		indexes = make(map[string]int)
+	)
+
+	for _, ref := range parseLsRemoteOutput(output.Stdout.String()) {
+		name, peeled := strings.CutSuffix(ref.Name, peeledSuffix)
+
+		if i, ok := indexes[name]; ok {
+			if peeled {
+				refs[i].Hash = ref.Hash
+			}
+
+			continue
+		}
+
+		indexes[name] = len(refs)
+		refs = append(refs, GitRef{Name: name, Hash: ref.Hash})
+	}
+
+	slices.SortFunc(refs, func(a, b GitRef) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return refs, nil
+}
+
+// parseLsRemoteOutput parses the `<hash> <ref>` lines of the `git ls-remote` output. The `ref: <target> <ref>` lines,
+// printed with the `--symref` flag, set the symref target of the ref.
+func parseLsRemoteOutput(output string) []GitRef {
+	var (
+		refs          []GitRef
+		symrefTargets = make(map[string]string)
+	)
+
+	for _, line := range strings.Split(output, "\n") {
+		if symref, ok := strings.CutPrefix(line, symrefPrefix); ok {
+			if fields := strings.Fields(symref); len(fields) >= tagSplitPart {
+				symrefTargets[fields[1]] = fields[0]
+			}
+
+			continue
+		}
 
-	for _, line := range tagLines {
 		fields := strings.Fields(line)
 		if len(fields) >= tagSplitPart {
-			tags = append(tags, fields[1])
+			refs = append(refs, GitRef{Name: fields[1], Hash: fields[0], SymrefTarget: symrefTargets[fields[1]]})
 		}
 	}
 
-	return tags, nil
+	return refs
+}
+
+// GitLsRemote lists the given `refs` of the git repository from passed url, e.g. `HEAD`, in the order reported by git.
+// The symbolic references have `SymrefTarget` set, so the default branch can be learned along with its commit in a single call.
+func GitLsRemote(ctx context.Context, opts *options.TerragruntOptions, gitRepo *url.URL, refs ...string) ([]GitRef, error) {
+	repoPath := gitRepo.String()
+	// remove git:: part if present
+	repoPath = strings.TrimPrefix(repoPath, gitPrefix)
+
+	args := append([]string{"ls-remote", "--symref", repoPath}, refs...)
+
+	output, err := RunCommandWithOutput(ctx, opts, opts.WorkingDir, true, false, "git", args...)
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	return parseLsRemoteOutput(output.Stdout.String()), nil
+}
+
+// GitRemoteDefaultBranch resolves the default branch of the git repository from passed url without cloning it.
+// An empty string is returned if the remote HEAD does not point to a branch.
+func GitRemoteDefaultBranch(ctx context.Context, opts *options.TerragruntOptions, gitRepo *url.URL) (string, error) {
+	refs, err := GitLsRemote(ctx, opts, gitRepo, "HEAD")
+	if err != nil {
+		return "", err
+	}
+
+	for _, ref := range refs {
+		if ref.Name == "HEAD" && strings.HasPrefix(ref.SymrefTarget, refsHeads) {
+			return strings.TrimPrefix(ref.SymrefTarget, refsHeads), nil
+		}
+	}
+
+	return "", nil
 }
 
 // GitLastReleaseTag fetches git repository last release tag.
@@ -0,0 +1,194 @@
+package shell_test
+
+import (
+	"context"
+	"maps"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/shell"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitIsInsideWorkTree(t *testing.T) {
+	t.Parallel()
+
+	repoDir := t.TempDir()
+	require.NoError(t, exec.Command("git", "init", "--quiet", repoDir).Run())
+
+	subDir := filepath.Join(repoDir, "modules", "vpc")
+	require.NoError(t, os.MkdirAll(subDir, os.ModePerm))
+
+	testCases := []struct {
+		env      map[string]string
+		name     string
+		dir      string
+		expected bool
+	}{
+		{
+			name:     "git dir",
+			dir:      repoDir,
+			expected: true,
+		},
+		{
+			name:     "subdir of git dir",
+			dir:      subDir,
+			expected: true,
+		},
+		{
+			name:     "non-git dir",
+			dir:      t.TempDir(),
+			expected: false,
+		},
+		{
+			name:     "non-git dir with localized messages",
+			dir:      t.TempDir(),
+			env:      map[string]string{"LANGUAGE": "de", "LC_ALL": "de_DE.UTF-8"},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts, err := options.NewTerragruntOptionsForTest("")
+			require.NoError(t, err)
+
+			if tc.env != nil {
+				opts.Env = maps.Clone(tc.env)
+			}
+
+			actual, err := shell.GitIsInsideWorkTree(context.Background(), opts, tc.dir)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+
+			// the locale is overridden for git only
+			if tc.env != nil {
+				assert.Equal(t, tc.env, opts.Env)
+			}
+		})
+	}
+}
+
+func TestGitRemoteRefs(t *testing.T) {
+	t.Parallel()
+
+	repoDir := t.TempDir()
+
+	git := func(args ...string) string {
+		args = append([]string{"-C", repoDir, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)
+
+		output, err := exec.Command("git", args...).CombinedOutput()
+		require.NoError(t, err, string(output))
+
+		return strings.TrimSpace(string(output))
+	}
+
+	git("init", "--quiet", "--initial-branch=main")
+	git("commit", "--quiet", "--allow-empty", "-m", "first")
+	git("tag", "v1.0.0")
+	git("tag", "other")
+	git("commit", "--quiet", "--allow-empty", "-m", "second")
+	git("tag", "-a", "v1.1.0", "-m", "annotated")
+	git("branch", "develop")
+
+	head := git("rev-parse", "HEAD")
+	first := git("rev-parse", "HEAD~1")
+
+	repoURL, err := url.Parse(repoDir)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		pattern  string
+		expected []shell.GitRef
+	}{
+		{
+			name:    "tags pattern",
+			pattern: "refs/tags/v*",
+			expected: []shell.GitRef{
+				{Name: "refs/tags/v1.0.0", Hash: first},
+				{Name: "refs/tags/v1.1.0", Hash: head},
+			},
+		},
+		{
+			name: "all refs",
+			expected: []shell.GitRef{
+				{Name: "refs/heads/develop", Hash: head},
+				{Name: "refs/heads/main", Hash: head},
+				{Name: "refs/tags/other", Hash: first},
+				{Name: "refs/tags/v1.0.0", Hash: first},
+				{Name: "refs/tags/v1.1.0", Hash: head},
+			},
+		},
+		{
+			name:    "no match",
+			pattern: "refs/tags/release-*",
+		},
+	}
+
+	shaRe := regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts, err := options.NewTerragruntOptionsForTest("")
+			require.NoError(t, err)
+
+			refs, err := shell.GitRemoteRefs(context.Background(), opts, repoURL, tc.pattern)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, refs)
+
+			for _, ref := range refs {
+				assert.Regexp(t, shaRe, ref.Hash)
+			}
+		})
+	}
+}
+
+func TestGitLsRemote(t *testing.T) {
+	t.Parallel()
+
+	repoDir := t.TempDir()
+
+	git := func(args ...string) string {
+		args = append([]string{"-C", repoDir, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)
+
+		output, err := exec.Command("git", args...).CombinedOutput()
+		require.NoError(t, err, string(output))
+
+		return strings.TrimSpace(string(output))
+	}
+
+	git("init", "--quiet", "--initial-branch=develop")
+	git("commit", "--quiet", "--allow-empty", "-m", "first")
+	git("tag", "v1.0.0")
+
+	head := git("rev-parse", "HEAD")
+
+	repoURL, err := url.Parse(repoDir)
+	require.NoError(t, err)
+
+	opts, err := options.NewTerragruntOptionsForTest("")
+	require.NoError(t, err)
+
+	refs, err := shell.GitLsRemote(context.Background(), opts, repoURL, "HEAD", "refs/tags/v1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, []shell.GitRef{
+		{Name: "HEAD", Hash: head, SymrefTarget: "refs/heads/develop"},
+		{Name: "refs/tags/v1.0.0", Hash: head},
+	}, refs)
+
+	defaultBranch, err := shell.GitRemoteDefaultBranch(context.Background(), opts, repoURL)
+	require.NoError(t, err)
+	assert.Equal(t, "develop", defaultBranch)
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 	"time"
 
@@ -75,6 +76,15 @@ func RunCommandWithOutput(
 			cmdStdout = io.MultiWriter(&output.Stdout)
 		}
 
+		var (
+			env        = opts.Env
+			cmdOptions = []exec.Option{
+				exec.WithLogger(opts.Logger),
+				exec.WithUsePTY(needsPTY),
+				exec.WithForwardSignalDelay(SignalForwardingDelay),
+			}
+		)
+
 		if command == opts.TerraformPath {
 			// If the engine is enabled and the command is IaC executable, use the engine to run the command.
 			if opts.Engine != nil && opts.EngineEnabled {
@@ -100,18 +110,33 @@ func RunCommandWithOutput(
 			}
 
 			opts.Logger.Debugf("Engine is not enabled, running command directly in %s", commandDir)
+
+			if len(opts.EnvAllowlist) > 0 {
+				opts.Logger.Debugf("Passing only the env vars allowed by %s and the ones set by Terragrunt", strings.Join(opts.EnvAllowlist, ", "))
+
+				env = allowedEnv(opts.Env, opts.EnvAllowlist)
+			}
+
+			if len(opts.TerraformWrapper) > 0 {
+				args = append(append(slices.Clone(opts.TerraformWrapper[1:]), command), args...)
+				command = opts.TerraformWrapper[0]
+
+				opts.Logger.Debugf("Running command through wrapper: %s %s", command, strings.Join(args, " "))
+			}
+
+			// only OpenTofu/Terraform is stopped the way the user configured, the hooks and other commands are interrupted as before
+			cmdOptions = append(cmdOptions,
+				exec.WithInterruptMode(exec.InterruptMode(opts.InterruptMode)),
+				exec.WithKillTimeout(opts.KillTimeout),
+				exec.WithProcessGroup(true),
+			)
 		}
 
 		cmd := exec.Command(command, args...)
 		cmd.Dir = commandDir
 		cmd.Stdout = cmdStdout
 		cmd.Stderr = cmdStderr
-		cmd.Configure(
-			exec.WithLogger(opts.Logger),
-			exec.WithUsePTY(needsPTY),
-			exec.WithEnv(opts.Env),
-			exec.WithForwardSignalDelay(SignalForwardingDelay),
-		)
+		cmd.Configure(append(cmdOptions, exec.WithEnv(env))...)
 
 		if err := cmd.Start(); err != nil { //nolint:contextcheck
 			err = util.ProcessExecutionError{
@@ -6,7 +6,9 @@ package shell_test
 import (
 	"context"
 	"fmt"
+	"os"
 	"strconv"
+	"strings"
 	"syscall"
 	"testing"
 	"time"
@@ -45,3 +47,40 @@ func TestRunCommandWithOutputInterrupt(t *testing.T) {
 	expectedErr := fmt.Sprintf("Failed to execute \"%s 5\" in .\n\nexit status %d", cmdPath, expectedWait)
 	assert.EqualError(t, actualErr, expectedErr)
 }
+
+//nolint:paralleltest // uses t.Setenv
+func TestRunCommandWithOutputEnvAllowlist(t *testing.T) {
+	t.Setenv("TG_TEST_CI_SECRET", "secret")
+	t.Setenv("TG_TEST_ALLOWED", "allowed")
+
+	terragruntOptions, err := options.NewTerragruntOptionsForTest("")
+	require.NoError(t, err)
+
+	for _, env := range os.Environ() {
+		if key, val, ok := strings.Cut(env, "="); ok {
+			terragruntOptions.Env[key] = val
+		}
+	}
+
+	// Set by Terragrunt, e.g. from the inputs.
+	terragruntOptions.Env["TF_VAR_name"] = "value"
+	terragruntOptions.EnvAllowlist = []string{"TG_TEST_ALLOWED"}
+	terragruntOptions.TerraformPath = "env"
+
+	out, err := shell.RunCommandWithOutput(context.Background(), terragruntOptions, "", true, false, "env")
+	require.NoError(t, err)
+
+	childEnv := strings.Split(out.Stdout.String(), "\n")
+
+	assert.NotContains(t, childEnv, "TG_TEST_CI_SECRET=secret")
+	assert.Contains(t, childEnv, "TG_TEST_ALLOWED=allowed")
+	assert.Contains(t, childEnv, "TF_VAR_name=value")
+	assert.Contains(t, childEnv, "PATH="+os.Getenv("PATH"))
+
+	// Without the allowlist, the whole environment is passed.
+	terragruntOptions.EnvAllowlist = nil
+
+	out, err = shell.RunCommandWithOutput(context.Background(), terragruntOptions, "", true, false, "env")
+	require.NoError(t, err)
+	assert.Contains(t, strings.Split(out.Stdout.String(), "\n"), "TG_TEST_CI_SECRET=secret")
+}
@@ -7,7 +7,9 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
@@ -122,3 +124,97 @@ func (s *BufferWithLocking) String() string {
 	defer s.mutex.Unlock()
 	return s.buffer.String()
 }
+
+func TestRunCommandExitCode(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                     string
+		args                     []string
+		exitCode                 int
+		expectedErr              bool
+		expectedDetailedExitCode int
+	}{
+		{
+			name:     "success",
+			args:     []string{"plan"},
+			exitCode: 0,
+		},
+		{
+			name:        "exit code without detailed exit code flag",
+			args:        []string{"plan"},
+			exitCode:    2,
+			expectedErr: true,
+		},
+		{
+			name:                     "detailed exit code with changes",
+			args:                     []string{"plan", "-detailed-exitcode"},
+			exitCode:                 2,
+			expectedDetailedExitCode: 2,
+		},
+		{
+			name:                     "detailed exit code with error",
+			args:                     []string{"plan", "-detailed-exitcode"},
+			exitCode:                 1,
+			expectedErr:              true,
+			expectedDetailedExitCode: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts, err := options.NewTerragruntOptionsForTest("")
+			require.NoError(t, err)
+
+			opts.TerraformPath = "testdata/test_exit_code.sh"
+			opts.Env = map[string]string{"EXIT_CODE": strconv.Itoa(tc.exitCode)}
+			opts.Writer = io.Discard
+			opts.ErrWriter = io.Discard
+
+			detailedExitCode := new(tf.DetailedExitCode)
+			ctx := tf.ContextWithDetailedExitCode(context.Background(), detailedExitCode)
+
+			err = tf.RunCommand(ctx, opts, tc.args...)
+
+			assert.Equal(t, tc.expectedDetailedExitCode, detailedExitCode.Get())
+
+			if !tc.expectedErr {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+
+			actualExitCode, err := util.GetExitCode(err)
+			require.NoError(t, err)
+			assert.Equal(t, tc.exitCode, actualExitCode)
+		})
+	}
+}
+
+func TestRunCommandWrapper(t *testing.T) {
+	t.Parallel()
+
+	var stdout bytes.Buffer
+
+	opts, err := options.NewTerragruntOptionsForTest("")
+	require.NoError(t, err)
+
+	opts.TerraformPath = "testdata/test_exit_code.sh"
+	opts.TerraformWrapper = []string{"testdata/test_wrapper.sh", "--wrapper-arg"}
+	opts.Env = map[string]string{"EXIT_CODE": "3"}
+	opts.ForwardTFStdout = true
+	opts.Writer = &stdout
+	opts.ErrWriter = io.Discard
+
+	err = tf.RunCommand(context.Background(), opts, "plan", "-input=false")
+
+	// The exit code of the binary is returned through the wrapper, which also proves that the env is preserved.
+	actualExitCode, exitCodeErr := util.GetExitCode(err)
+	require.NoError(t, exitCodeErr)
+	assert.Equal(t, 3, actualExitCode)
+
+	assert.Equal(t, "wrapper: --wrapper-arg testdata/test_exit_code.sh plan -input=false\n", stdout.String())
+}
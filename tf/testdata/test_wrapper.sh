@@ -0,0 +1,5 @@
+#!/bin/sh
+echo "wrapper: $*"
+# The first argument belongs to the wrapper itself.
+shift
+exec "$@"
@@ -48,6 +48,8 @@ const (
 	FlagNameVersion          = "-version"
 	FlagNameJSON             = "-json"
 	FlagNameNoColor          = "-no-color"
+	FlagNameVar              = "-var"
+	FlagNameVarFile          = "-var-file"
 	// `apply -destroy` is alias for `destroy`
 	FlagNameDestroy = "-destroy"
 
@@ -919,6 +919,12 @@ func WalkWithSymlinks(root string, externalWalkFn filepath.WalkFunc) error {
 				visitedLogical[logicalPath] = true
 
 				if err := externalWalkFn(logicalPath, realInfo, nil); err != nil {
+					// `filepath.Walk` treats the symlink as a file, for which `SkipDir` would skip the rest of the parent directory,
+					// so the symlinked directory is skipped by not following it instead.
+					if errors.Is(err, filepath.SkipDir) && info.Mode()&os.ModeSymlink != 0 {
+						return nil
+					}
+
 					return err
 				}
 			}
@@ -645,6 +645,44 @@ func TestWalkWithCircularSymlinks(t *testing.T) {
 	}
 }
 
+func TestWalkWithSymlinksSkipDir(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+
+	for _, dir := range []string{"target/nested", "root/b-dir/nested"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(tempDir, dir), 0755))
+	}
+
+	// the symlink is walked before its sibling `b-dir`
+	require.NoError(t, os.Symlink(filepath.Join(tempDir, "target"), filepath.Join(tempDir, "root", "a-link")))
+
+	root := filepath.Join(tempDir, "root")
+
+	var paths []string
+
+	err = util.WalkWithSymlinks(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(root, path)
+		require.NoError(t, err)
+
+		paths = append(paths, filepath.ToSlash(relPath))
+
+		if relPath != "." && info.IsDir() {
+			return filepath.SkipDir
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{".", "a-link", "b-dir"}, paths)
+}
+
 func TestWalkWithSymlinksErrors(t *testing.T) {
 	t.Parallel()
 